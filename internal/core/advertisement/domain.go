@@ -0,0 +1,56 @@
+package advertisement
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"go-api/pkg/utils"
+)
+
+type Handlers interface {
+	Create() gin.HandlerFunc
+	Update() gin.HandlerFunc
+	Cancel() gin.HandlerFunc
+	ChangeStatus() gin.HandlerFunc
+	GetByID() gin.HandlerFunc
+	GetByShareToken() gin.HandlerFunc
+	GetAdvertisements() gin.HandlerFunc
+	Search() gin.HandlerFunc
+	Nearby() gin.HandlerFunc
+}
+
+type Repository interface {
+	Create(ctx context.Context, ad *Model, values []*FieldValue) (*Model, error)
+	Update(ctx context.Context, ad *Model, values []*FieldValue) (*Model, error)
+	UpdateStatus(ctx context.Context, adID uuid.UUID, from, to Status) (*Model, error)
+	ExpireDue(ctx context.Context, now time.Time, limit int, canExpire func(ad *Model) error) ([]*Model, error)
+	GetByID(ctx context.Context, adID uuid.UUID) (*Model, error)
+	GetByShareToken(ctx context.Context, token string) (*Model, error)
+	IsParticipant(ctx context.Context, adID, userID uuid.UUID) (bool, error)
+	GetFieldValues(ctx context.Context, adID uuid.UUID) ([]*FieldValue, error)
+	GetPageFieldValues(ctx context.Context, adIDs []uuid.UUID) ([]*FieldValue, error)
+	GetAdvertisements(ctx context.Context, query *ListQuery, pq *utils.PaginationQuery) (*List, error)
+	Search(ctx context.Context, query *SearchQuery, pq *utils.PaginationQuery) (*SearchList, error)
+	Nearby(ctx context.Context, query *NearbyQuery, pq *utils.PaginationQuery) (*NearbyList, error)
+}
+
+// Publisher notifies the rest of the system about advertisement events
+type Publisher interface {
+	Publish(ctx context.Context, event *Event) error
+}
+
+type UseCase interface {
+	Create(ctx context.Context, ad *Model) (*Model, error)
+	Update(ctx context.Context, ad *Model) (*Model, error)
+	Cancel(ctx context.Context, adID uuid.UUID) (*Model, error)
+	ChangeStatus(ctx context.Context, adID uuid.UUID, to Status) (*Model, error)
+	Expire(ctx context.Context, now time.Time, limit int) ([]*Model, error)
+	GetByID(ctx context.Context, adID uuid.UUID) (*Model, error)
+	GetByShareToken(ctx context.Context, token string) (*Model, error)
+	GetAdvertisements(ctx context.Context, query *ListQuery, pq *utils.PaginationQuery) (*List, error)
+	Search(ctx context.Context, query *SearchQuery, pq *utils.PaginationQuery) (*SearchList, error)
+	Nearby(ctx context.Context, query *NearbyQuery, pq *utils.PaginationQuery) (*NearbyList, error)
+}
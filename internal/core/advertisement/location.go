@@ -0,0 +1,152 @@
+package advertisement
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go-api/pkg/apierrors"
+	"go-api/pkg/geo"
+)
+
+const (
+	maxAddressLength = 120
+	// MaxRadiusKm bounds radius searches
+	MaxRadiusKm = 500
+)
+
+var countryRegex = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// Location model store the address where the job takes place and its
+// coordinates. It is kept in the advertisements location column.
+type Location struct {
+	Street     string `json:"street,omitempty"`
+	Number     string `json:"number,omitempty"`
+	District   string `json:"district,omitempty"`
+	City       string `json:"city"`
+	State      string `json:"state,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+	Country    string `json:"country"`
+	geo.Point
+}
+
+// NearbyQuery model store a radius search around a point
+type NearbyQuery struct {
+	Center   geo.Point
+	RadiusKm float64
+	Status   Status
+}
+
+// NearbyResult model store an advertisement found by a radius search
+type NearbyResult struct {
+	Model
+	DistanceKm float64 `json:"distance_km" db:"distance_km"`
+}
+
+// MarshalJSON keeps the distance next to the advertisement fields, which the
+// promoted Model.MarshalJSON would drop
+func (r NearbyResult) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		modelJSON
+		DistanceKm float64 `json:"distance_km"`
+	}{r.Model.toJSON(), r.DistanceKm})
+}
+
+// NearbyList model store radius search pages
+type NearbyList struct {
+	TotalCount int              `json:"total_count"`
+	TotalPages int              `json:"total_pages"`
+	Page       int              `json:"page"`
+	Size       int              `json:"size"`
+	HasMore    bool             `json:"has_more"`
+	Results    *[]*NearbyResult `json:"results"`
+}
+
+// UnmarshalJSON requires both coordinates, so a location sent without them
+// is rejected instead of being placed at the point (0, 0)
+func (l *Location) UnmarshalJSON(data []byte) error {
+	type location Location
+
+	var v struct {
+		location
+		Latitude  *float64 `json:"latitude"`
+		Longitude *float64 `json:"longitude"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	if v.Latitude == nil || v.Longitude == nil {
+		return apierrors.BadRequest("location latitude and longitude are required")
+	}
+
+	*l = Location(v.location)
+	l.Point = geo.Point{Latitude: *v.Latitude, Longitude: *v.Longitude}
+	return nil
+}
+
+// Validate checks the address components and the coordinates
+func (l *Location) Validate() error {
+	for _, s := range []*string{&l.Street, &l.Number, &l.District, &l.City, &l.State, &l.PostalCode} {
+		*s = strings.TrimSpace(*s)
+		if len(*s) > maxAddressLength {
+			return apierrors.BadRequest("location fields are too long")
+		}
+	}
+
+	if l.City == "" {
+		return apierrors.BadRequest("location city is required")
+	}
+
+	l.Country = strings.ToUpper(strings.TrimSpace(l.Country))
+	if !countryRegex.MatchString(l.Country) {
+		return apierrors.BadRequest("location country must be a 2 letter ISO 3166 code")
+	}
+
+	if err := l.Point.Validate(); err != nil {
+		return apierrors.BadRequest(err.Error())
+	}
+
+	return nil
+}
+
+// Validate checks the center and the radius of the search
+func (q *NearbyQuery) Validate() error {
+	if err := q.Center.Validate(); err != nil {
+		return apierrors.BadRequest(err.Error())
+	}
+
+	if !(q.RadiusKm > 0 && q.RadiusKm <= MaxRadiusKm) {
+		return apierrors.BadRequest(fmt.Sprintf("radius_km must be greater than 0 and at most %d", MaxRadiusKm))
+	}
+
+	if q.Status != "" && !q.Status.IsValid() {
+		return apierrors.BadRequest("invalid status")
+	}
+
+	return nil
+}
+
+// Scan implements the sql.Scanner interface for the JSONB location column
+func (l *Location) Scan(src any) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, l)
+	case string:
+		return json.Unmarshal([]byte(v), l)
+	default:
+		return fmt.Errorf("advertisement.Location.Scan: unsupported type %T", src)
+	}
+}
+
+// Value implements the driver.Valuer interface for the JSONB location column
+func (l Location) Value() (driver.Value, error) {
+	b, err := json.Marshal(l)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,172 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package advertisementmock
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Handlers is an autogenerated mock type for the Handlers type
+type Handlers struct {
+	mock.Mock
+}
+
+// Cancel provides a mock function with given fields:
+func (_m *Handlers) Cancel() gin.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 gin.HandlerFunc
+	if rf, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+
+	return r0
+}
+
+// ChangeStatus provides a mock function with given fields:
+func (_m *Handlers) ChangeStatus() gin.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 gin.HandlerFunc
+	if rf, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+
+	return r0
+}
+
+// Create provides a mock function with given fields:
+func (_m *Handlers) Create() gin.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 gin.HandlerFunc
+	if rf, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+
+	return r0
+}
+
+// GetAdvertisements provides a mock function with given fields:
+func (_m *Handlers) GetAdvertisements() gin.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 gin.HandlerFunc
+	if rf, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+
+	return r0
+}
+
+// GetByID provides a mock function with given fields:
+func (_m *Handlers) GetByID() gin.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 gin.HandlerFunc
+	if rf, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+
+	return r0
+}
+
+// GetByShareToken provides a mock function with given fields:
+func (_m *Handlers) GetByShareToken() gin.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 gin.HandlerFunc
+	if rf, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+
+	return r0
+}
+
+// Nearby provides a mock function with given fields:
+func (_m *Handlers) Nearby() gin.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 gin.HandlerFunc
+	if rf, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+
+	return r0
+}
+
+// Search provides a mock function with given fields:
+func (_m *Handlers) Search() gin.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 gin.HandlerFunc
+	if rf, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields:
+func (_m *Handlers) Update() gin.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 gin.HandlerFunc
+	if rf, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewHandlers interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewHandlers creates a new instance of Handlers. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewHandlers(t mockConstructorTestingTNewHandlers) *Handlers {
+	mock := &Handlers{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,346 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package advertisementmock
+
+import (
+	context "context"
+	advertisement "go-api/internal/core/advertisement"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	utils "go-api/pkg/utils"
+
+	uuid "github.com/google/uuid"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, ad, values
+func (_m *Repository) Create(ctx context.Context, ad *advertisement.Model, values []*advertisement.FieldValue) (*advertisement.Model, error) {
+	ret := _m.Called(ctx, ad, values)
+
+	var r0 *advertisement.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *advertisement.Model, []*advertisement.FieldValue) (*advertisement.Model, error)); ok {
+		return rf(ctx, ad, values)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *advertisement.Model, []*advertisement.FieldValue) *advertisement.Model); ok {
+		r0 = rf(ctx, ad, values)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*advertisement.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *advertisement.Model, []*advertisement.FieldValue) error); ok {
+		r1 = rf(ctx, ad, values)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExpireDue provides a mock function with given fields: ctx, now, limit, canExpire
+func (_m *Repository) ExpireDue(ctx context.Context, now time.Time, limit int, canExpire func(*advertisement.Model) error) ([]*advertisement.Model, error) {
+	ret := _m.Called(ctx, now, limit, canExpire)
+
+	var r0 []*advertisement.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int, func(*advertisement.Model) error) ([]*advertisement.Model, error)); ok {
+		return rf(ctx, now, limit, canExpire)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int, func(*advertisement.Model) error) []*advertisement.Model); ok {
+		r0 = rf(ctx, now, limit, canExpire)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*advertisement.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int, func(*advertisement.Model) error) error); ok {
+		r1 = rf(ctx, now, limit, canExpire)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAdvertisements provides a mock function with given fields: ctx, query, pq
+func (_m *Repository) GetAdvertisements(ctx context.Context, query *advertisement.ListQuery, pq *utils.PaginationQuery) (*advertisement.List, error) {
+	ret := _m.Called(ctx, query, pq)
+
+	var r0 *advertisement.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *advertisement.ListQuery, *utils.PaginationQuery) (*advertisement.List, error)); ok {
+		return rf(ctx, query, pq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *advertisement.ListQuery, *utils.PaginationQuery) *advertisement.List); ok {
+		r0 = rf(ctx, query, pq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*advertisement.List)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *advertisement.ListQuery, *utils.PaginationQuery) error); ok {
+		r1 = rf(ctx, query, pq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByID provides a mock function with given fields: ctx, adID
+func (_m *Repository) GetByID(ctx context.Context, adID uuid.UUID) (*advertisement.Model, error) {
+	ret := _m.Called(ctx, adID)
+
+	var r0 *advertisement.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*advertisement.Model, error)); ok {
+		return rf(ctx, adID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *advertisement.Model); ok {
+		r0 = rf(ctx, adID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*advertisement.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, adID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByShareToken provides a mock function with given fields: ctx, token
+func (_m *Repository) GetByShareToken(ctx context.Context, token string) (*advertisement.Model, error) {
+	ret := _m.Called(ctx, token)
+
+	var r0 *advertisement.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*advertisement.Model, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *advertisement.Model); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*advertisement.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFieldValues provides a mock function with given fields: ctx, adID
+func (_m *Repository) GetFieldValues(ctx context.Context, adID uuid.UUID) ([]*advertisement.FieldValue, error) {
+	ret := _m.Called(ctx, adID)
+
+	var r0 []*advertisement.FieldValue
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*advertisement.FieldValue, error)); ok {
+		return rf(ctx, adID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*advertisement.FieldValue); ok {
+		r0 = rf(ctx, adID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*advertisement.FieldValue)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, adID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPageFieldValues provides a mock function with given fields: ctx, adIDs
+func (_m *Repository) GetPageFieldValues(ctx context.Context, adIDs []uuid.UUID) ([]*advertisement.FieldValue, error) {
+	ret := _m.Called(ctx, adIDs)
+
+	var r0 []*advertisement.FieldValue
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]*advertisement.FieldValue, error)); ok {
+		return rf(ctx, adIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []*advertisement.FieldValue); ok {
+		r0 = rf(ctx, adIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*advertisement.FieldValue)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, adIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IsParticipant provides a mock function with given fields: ctx, adID, userID
+func (_m *Repository) IsParticipant(ctx context.Context, adID uuid.UUID, userID uuid.UUID) (bool, error) {
+	ret := _m.Called(ctx, adID, userID)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (bool, error)); ok {
+		return rf(ctx, adID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) bool); ok {
+		r0 = rf(ctx, adID, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, adID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Nearby provides a mock function with given fields: ctx, query, pq
+func (_m *Repository) Nearby(ctx context.Context, query *advertisement.NearbyQuery, pq *utils.PaginationQuery) (*advertisement.NearbyList, error) {
+	ret := _m.Called(ctx, query, pq)
+
+	var r0 *advertisement.NearbyList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *advertisement.NearbyQuery, *utils.PaginationQuery) (*advertisement.NearbyList, error)); ok {
+		return rf(ctx, query, pq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *advertisement.NearbyQuery, *utils.PaginationQuery) *advertisement.NearbyList); ok {
+		r0 = rf(ctx, query, pq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*advertisement.NearbyList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *advertisement.NearbyQuery, *utils.PaginationQuery) error); ok {
+		r1 = rf(ctx, query, pq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Search provides a mock function with given fields: ctx, query, pq
+func (_m *Repository) Search(ctx context.Context, query *advertisement.SearchQuery, pq *utils.PaginationQuery) (*advertisement.SearchList, error) {
+	ret := _m.Called(ctx, query, pq)
+
+	var r0 *advertisement.SearchList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *advertisement.SearchQuery, *utils.PaginationQuery) (*advertisement.SearchList, error)); ok {
+		return rf(ctx, query, pq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *advertisement.SearchQuery, *utils.PaginationQuery) *advertisement.SearchList); ok {
+		r0 = rf(ctx, query, pq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*advertisement.SearchList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *advertisement.SearchQuery, *utils.PaginationQuery) error); ok {
+		r1 = rf(ctx, query, pq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, ad, values
+func (_m *Repository) Update(ctx context.Context, ad *advertisement.Model, values []*advertisement.FieldValue) (*advertisement.Model, error) {
+	ret := _m.Called(ctx, ad, values)
+
+	var r0 *advertisement.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *advertisement.Model, []*advertisement.FieldValue) (*advertisement.Model, error)); ok {
+		return rf(ctx, ad, values)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *advertisement.Model, []*advertisement.FieldValue) *advertisement.Model); ok {
+		r0 = rf(ctx, ad, values)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*advertisement.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *advertisement.Model, []*advertisement.FieldValue) error); ok {
+		r1 = rf(ctx, ad, values)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateStatus provides a mock function with given fields: ctx, adID, from, to
+func (_m *Repository) UpdateStatus(ctx context.Context, adID uuid.UUID, from advertisement.Status, to advertisement.Status) (*advertisement.Model, error) {
+	ret := _m.Called(ctx, adID, from, to)
+
+	var r0 *advertisement.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, advertisement.Status, advertisement.Status) (*advertisement.Model, error)); ok {
+		return rf(ctx, adID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, advertisement.Status, advertisement.Status) *advertisement.Model); ok {
+		r0 = rf(ctx, adID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*advertisement.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, advertisement.Status, advertisement.Status) error); ok {
+		r1 = rf(ctx, adID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRepository(t mockConstructorTestingTNewRepository) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,296 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package advertisementmock
+
+import (
+	context "context"
+	advertisement "go-api/internal/core/advertisement"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	utils "go-api/pkg/utils"
+
+	uuid "github.com/google/uuid"
+)
+
+// UseCase is an autogenerated mock type for the UseCase type
+type UseCase struct {
+	mock.Mock
+}
+
+// Cancel provides a mock function with given fields: ctx, adID
+func (_m *UseCase) Cancel(ctx context.Context, adID uuid.UUID) (*advertisement.Model, error) {
+	ret := _m.Called(ctx, adID)
+
+	var r0 *advertisement.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*advertisement.Model, error)); ok {
+		return rf(ctx, adID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *advertisement.Model); ok {
+		r0 = rf(ctx, adID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*advertisement.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, adID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChangeStatus provides a mock function with given fields: ctx, adID, to
+func (_m *UseCase) ChangeStatus(ctx context.Context, adID uuid.UUID, to advertisement.Status) (*advertisement.Model, error) {
+	ret := _m.Called(ctx, adID, to)
+
+	var r0 *advertisement.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, advertisement.Status) (*advertisement.Model, error)); ok {
+		return rf(ctx, adID, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, advertisement.Status) *advertisement.Model); ok {
+		r0 = rf(ctx, adID, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*advertisement.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, advertisement.Status) error); ok {
+		r1 = rf(ctx, adID, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: ctx, ad
+func (_m *UseCase) Create(ctx context.Context, ad *advertisement.Model) (*advertisement.Model, error) {
+	ret := _m.Called(ctx, ad)
+
+	var r0 *advertisement.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *advertisement.Model) (*advertisement.Model, error)); ok {
+		return rf(ctx, ad)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *advertisement.Model) *advertisement.Model); ok {
+		r0 = rf(ctx, ad)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*advertisement.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *advertisement.Model) error); ok {
+		r1 = rf(ctx, ad)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Expire provides a mock function with given fields: ctx, now, limit
+func (_m *UseCase) Expire(ctx context.Context, now time.Time, limit int) ([]*advertisement.Model, error) {
+	ret := _m.Called(ctx, now, limit)
+
+	var r0 []*advertisement.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]*advertisement.Model, error)); ok {
+		return rf(ctx, now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []*advertisement.Model); ok {
+		r0 = rf(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*advertisement.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAdvertisements provides a mock function with given fields: ctx, query, pq
+func (_m *UseCase) GetAdvertisements(ctx context.Context, query *advertisement.ListQuery, pq *utils.PaginationQuery) (*advertisement.List, error) {
+	ret := _m.Called(ctx, query, pq)
+
+	var r0 *advertisement.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *advertisement.ListQuery, *utils.PaginationQuery) (*advertisement.List, error)); ok {
+		return rf(ctx, query, pq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *advertisement.ListQuery, *utils.PaginationQuery) *advertisement.List); ok {
+		r0 = rf(ctx, query, pq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*advertisement.List)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *advertisement.ListQuery, *utils.PaginationQuery) error); ok {
+		r1 = rf(ctx, query, pq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByID provides a mock function with given fields: ctx, adID
+func (_m *UseCase) GetByID(ctx context.Context, adID uuid.UUID) (*advertisement.Model, error) {
+	ret := _m.Called(ctx, adID)
+
+	var r0 *advertisement.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*advertisement.Model, error)); ok {
+		return rf(ctx, adID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *advertisement.Model); ok {
+		r0 = rf(ctx, adID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*advertisement.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, adID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByShareToken provides a mock function with given fields: ctx, token
+func (_m *UseCase) GetByShareToken(ctx context.Context, token string) (*advertisement.Model, error) {
+	ret := _m.Called(ctx, token)
+
+	var r0 *advertisement.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*advertisement.Model, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *advertisement.Model); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*advertisement.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Nearby provides a mock function with given fields: ctx, query, pq
+func (_m *UseCase) Nearby(ctx context.Context, query *advertisement.NearbyQuery, pq *utils.PaginationQuery) (*advertisement.NearbyList, error) {
+	ret := _m.Called(ctx, query, pq)
+
+	var r0 *advertisement.NearbyList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *advertisement.NearbyQuery, *utils.PaginationQuery) (*advertisement.NearbyList, error)); ok {
+		return rf(ctx, query, pq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *advertisement.NearbyQuery, *utils.PaginationQuery) *advertisement.NearbyList); ok {
+		r0 = rf(ctx, query, pq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*advertisement.NearbyList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *advertisement.NearbyQuery, *utils.PaginationQuery) error); ok {
+		r1 = rf(ctx, query, pq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Search provides a mock function with given fields: ctx, query, pq
+func (_m *UseCase) Search(ctx context.Context, query *advertisement.SearchQuery, pq *utils.PaginationQuery) (*advertisement.SearchList, error) {
+	ret := _m.Called(ctx, query, pq)
+
+	var r0 *advertisement.SearchList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *advertisement.SearchQuery, *utils.PaginationQuery) (*advertisement.SearchList, error)); ok {
+		return rf(ctx, query, pq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *advertisement.SearchQuery, *utils.PaginationQuery) *advertisement.SearchList); ok {
+		r0 = rf(ctx, query, pq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*advertisement.SearchList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *advertisement.SearchQuery, *utils.PaginationQuery) error); ok {
+		r1 = rf(ctx, query, pq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, ad
+func (_m *UseCase) Update(ctx context.Context, ad *advertisement.Model) (*advertisement.Model, error) {
+	ret := _m.Called(ctx, ad)
+
+	var r0 *advertisement.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *advertisement.Model) (*advertisement.Model, error)); ok {
+		return rf(ctx, ad)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *advertisement.Model) *advertisement.Model); ok {
+		r0 = rf(ctx, ad)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*advertisement.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *advertisement.Model) error); ok {
+		r1 = rf(ctx, ad)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewUseCase interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewUseCase creates a new instance of UseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewUseCase(t mockConstructorTestingTNewUseCase) *UseCase {
+	mock := &UseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,240 @@
+package advertisement
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"go-api/internal/core/field"
+	"go-api/pkg/apierrors"
+	"go-api/pkg/money"
+)
+
+const maxTitleLength = 250
+
+// Status of an advertisement, mirroring the ADSTATUS enum
+type Status string
+
+const (
+	StatusOpened    Status = "opened"
+	StatusCompleted Status = "completed"
+	StatusCanceled  Status = "canceled"
+	StatusExpired   Status = "expired"
+)
+
+// ErrCodeInvalidTransition is the API error code returned when an
+// advertisement cannot move from its current status to the requested one
+const ErrCodeInvalidTransition = "INVALID_STATUS_TRANSITION"
+
+// IsValid reports whether the status is one of the ADSTATUS values
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusOpened, StatusCompleted, StatusCanceled, StatusExpired:
+		return true
+	default:
+		return false
+	}
+}
+
+// EventType of the events published about advertisements
+type EventType string
+
+const (
+	EventAssigned EventType = "advertisement.assigned"
+	EventExpired  EventType = "advertisement.expired"
+)
+
+// Model model store advertisement data. Its prices are stored in minor
+// units of Currency and sent as money, see MarshalJSON.
+type Model struct {
+	ID                uuid.UUID      `json:"id" db:"id"`
+	CostumerID        uuid.UUID      `json:"costumer_id" db:"costumer_id"`
+	CategoryID        uuid.UUID      `json:"category_id" db:"category_id"`
+	Title             string         `json:"title" db:"title"`
+	Description       string         `json:"description" db:"description"`
+	Status            Status         `json:"status" db:"status"`
+	Currency          string         `json:"currency" db:"currency"`
+	Price             *int64         `json:"price" db:"price"`
+	ExpirationDate    time.Time      `json:"expiration_date" db:"expiration_date"`
+	SelectedCandidate *uuid.UUID     `json:"selected_candidate" db:"selected_cadidate"`
+	AcceptedPrice     *int64         `json:"accepted_price" db:"accepted_price"`
+	Location          *Location      `json:"location,omitempty" db:"location"`
+	Visibility        Visibility     `json:"visibility" db:"visibility"`
+	ShareToken        *string        `json:"share_token,omitempty" db:"share_token"`
+	Fields            map[string]any `json:"fields,omitempty" db:"-"`
+	CreatedAt         time.Time      `json:"created_at" db:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at" db:"updated_at"`
+}
+
+// FieldValue model store the value of a category field for an advertisement
+type FieldValue struct {
+	AdvertisementID uuid.UUID  `json:"advertisement_id" db:"advertisement_id"`
+	FieldID         uuid.UUID  `json:"field_id" db:"field_id"`
+	Name            string     `json:"name" db:"name"`
+	Type            field.Type `json:"type" db:"type"`
+	Value           string     `json:"value" db:"value"`
+}
+
+// List model store advertisement pages
+type List struct {
+	TotalCount     int       `json:"total_count"`
+	TotalPages     int       `json:"total_pages"`
+	Page           int       `json:"page"`
+	Size           int       `json:"size"`
+	HasMore        bool      `json:"has_more"`
+	Advertisements *[]*Model `json:"advertisements"`
+}
+
+// StatusChange model store a requested status transition
+type StatusChange struct {
+	Status Status `json:"status"`
+}
+
+// Event model store an advertisement event
+type Event struct {
+	Type            EventType  `json:"type"`
+	AdvertisementID uuid.UUID  `json:"advertisement_id"`
+	CostumerID      uuid.UUID  `json:"costumer_id"`
+	WorkerID        *uuid.UUID `json:"worker_id,omitempty"`
+	Status          Status     `json:"status"`
+	OccurredAt      time.Time  `json:"occurred_at"`
+}
+
+// NewEvent builds an event of the given type from the advertisement state
+func NewEvent(eventType EventType, ad *Model) *Event {
+	return &Event{
+		Type:            eventType,
+		AdvertisementID: ad.ID,
+		CostumerID:      ad.CostumerID,
+		WorkerID:        ad.SelectedCandidate,
+		Status:          ad.Status,
+		OccurredAt:      time.Now().UTC(),
+	}
+}
+
+// Validate checks the advertisement data sent by the costumer
+func (m *Model) Validate() error {
+	m.Title = strings.TrimSpace(m.Title)
+	if m.Title == "" {
+		return apierrors.BadRequest("title is required")
+	}
+
+	if len(m.Title) > maxTitleLength {
+		return apierrors.BadRequest("title is too long")
+	}
+
+	if strings.TrimSpace(m.Description) == "" {
+		return apierrors.BadRequest("description is required")
+	}
+
+	if m.CategoryID == uuid.Nil {
+		return apierrors.BadRequest("category_id is required")
+	}
+
+	m.Currency = strings.ToUpper(m.Currency)
+	if !money.IsValidCurrency(m.Currency) {
+		return apierrors.BadRequest("invalid currency")
+	}
+
+	if price := m.PriceMoney(); price != nil && price.IsNegative() {
+		return apierrors.BadRequest("price must not be negative")
+	}
+
+	if m.Visibility != "" && !m.Visibility.IsValid() {
+		return apierrors.BadRequest("invalid visibility")
+	}
+
+	if !m.ExpirationDate.After(time.Now()) {
+		return apierrors.BadRequest("expiration_date must be in the future")
+	}
+
+	if m.Location != nil {
+		return m.Location.Validate()
+	}
+
+	return nil
+}
+
+// PriceMoney returns the price in the advertisement currency, or nil when
+// the costumer expects workers to bid
+func (m *Model) PriceMoney() *money.Money {
+	if m.Price == nil {
+		return nil
+	}
+
+	return &money.Money{Amount: *m.Price, Currency: m.Currency}
+}
+
+// model has the fields of Model without its JSON methods
+type model Model
+
+// modelJSON is the JSON form of Model, with the prices as money in the
+// advertisement currency
+type modelJSON struct {
+	*model
+	Price         *money.Money `json:"price"`
+	AcceptedPrice *money.Money `json:"accepted_price"`
+}
+
+func (m *Model) toJSON() modelJSON {
+	v := modelJSON{model: (*model)(m), Price: m.PriceMoney()}
+	if m.AcceptedPrice != nil {
+		v.AcceptedPrice = &money.Money{Amount: *m.AcceptedPrice, Currency: m.Currency}
+	}
+
+	return v
+}
+
+func (m Model) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.toJSON())
+}
+
+// UnmarshalJSON reads the prices in minor units or as decimal strings, like
+// bids. The advertisement currency defaults to the price one and must match
+// it when both are sent.
+func (m *Model) UnmarshalJSON(data []byte) error {
+	v := modelJSON{model: (*model)(m)}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	for _, p := range []*money.Money{v.Price, v.AcceptedPrice} {
+		if p == nil {
+			continue
+		}
+		if m.Currency == "" {
+			m.Currency = p.Currency
+		}
+		if !strings.EqualFold(m.Currency, p.Currency) {
+			return money.ErrCurrencyMismatch
+		}
+	}
+
+	if v.Price != nil {
+		m.Price = &v.Price.Amount
+	}
+	if v.AcceptedPrice != nil {
+		m.AcceptedPrice = &v.AcceptedPrice.Amount
+	}
+
+	return nil
+}
+
+func (m *Model) IsOwner(userID uuid.UUID) bool {
+	return m.CostumerID == userID
+}
+
+// SetFieldValues fills Fields with the typed form of the stored values
+func (m *Model) SetFieldValues(values []*FieldValue) {
+	m.Fields = make(map[string]any, len(values))
+	for _, v := range values {
+		f := &field.Model{Type: v.Type}
+		typed, err := f.Decode(v.Value)
+		if err != nil {
+			typed = v.Value
+		}
+		m.Fields[v.Name] = typed
+	}
+}
@@ -0,0 +1,121 @@
+package advertisement_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go-api/internal/core/advertisement"
+	"go-api/pkg/apierrors"
+	"go-api/pkg/geo"
+)
+
+func TestModel_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name         string
+		data         string
+		wantPrice    *int64
+		wantCurrency string
+		wantErr      bool
+	}{
+		{"Success with decimal price", `{"price":{"value":"150.50","currency":"BRL"}}`, int64Ptr(15050), "BRL", false},
+		{"Success with minor units price", `{"currency":"brl","price":{"amount":15050,"currency":"BRL"}}`, int64Ptr(15050), "brl", false},
+		{"Success without price", `{"currency":"BRL","price":null}`, nil, "BRL", false},
+		{"Fail with price in another currency", `{"currency":"USD","price":{"amount":15050,"currency":"BRL"}}`, nil, "", true},
+		{"Fail with too many decimal places", `{"price":{"value":"150.505","currency":"BRL"}}`, nil, "", true},
+		{"Fail with unknown currency", `{"price":{"value":"150.50","currency":"XYZ"}}`, nil, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ad := &advertisement.Model{}
+			err := json.Unmarshal([]byte(tt.data), ad)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantPrice, ad.Price)
+			assert.Equal(t, tt.wantCurrency, ad.Currency)
+		})
+	}
+}
+
+func TestModel_MarshalJSON(t *testing.T) {
+	ad := advertisement.Model{Title: "Fake title", Currency: "BRL", Price: int64Ptr(15050)}
+
+	t.Run("Success with price as money", func(t *testing.T) {
+		data, err := json.Marshal(ad)
+		assert.NoError(t, err)
+
+		got := map[string]any{}
+		assert.NoError(t, json.Unmarshal(data, &got))
+		assert.Equal(t, "Fake title", got["title"])
+		assert.Equal(t, map[string]any{"amount": float64(15050), "currency": "BRL", "value": "150.50"}, got["price"])
+		assert.Nil(t, got["accepted_price"])
+	})
+
+	t.Run("Success keeping search fields", func(t *testing.T) {
+		data, err := json.Marshal(&advertisement.SearchResult{Model: ad, Rank: 0.5, Snippet: "<mark>fake</mark>"})
+		assert.NoError(t, err)
+
+		got := map[string]any{}
+		assert.NoError(t, json.Unmarshal(data, &got))
+		assert.Equal(t, 0.5, got["rank"])
+		assert.Equal(t, "<mark>fake</mark>", got["snippet"])
+		assert.Equal(t, "150.50", got["price"].(map[string]any)["value"])
+	})
+
+	t.Run("Success keeping nearby fields", func(t *testing.T) {
+		data, err := json.Marshal(&advertisement.NearbyResult{Model: ad, DistanceKm: 3})
+		assert.NoError(t, err)
+
+		got := map[string]any{}
+		assert.NoError(t, json.Unmarshal(data, &got))
+		assert.Equal(t, float64(3), got["distance_km"])
+		assert.Equal(t, "150.50", got["price"].(map[string]any)["value"])
+	})
+}
+
+func TestLocation_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    geo.Point
+		wantErr bool
+	}{
+		{"Success with coordinates", `{"city":"Santos","country":"BR","latitude":-23.96,"longitude":-46.33}`, geo.Point{Latitude: -23.96, Longitude: -46.33}, false},
+		{"Success with the origin sent explicitly", `{"city":"Santos","country":"BR","latitude":0,"longitude":0}`, geo.Point{}, false},
+		{"Fail without coordinates", `{"city":"Santos","country":"BR"}`, geo.Point{}, true},
+		{"Fail without longitude", `{"city":"Santos","country":"BR","latitude":-23.96}`, geo.Point{}, true},
+		{"Fail with null latitude", `{"city":"Santos","country":"BR","latitude":null,"longitude":-46.33}`, geo.Point{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc := &advertisement.Location{}
+			err := json.Unmarshal([]byte(tt.data), loc)
+			if tt.wantErr {
+				assert.Equal(t, http.StatusBadRequest, apierrors.Parse(err).StatusCode())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "Santos", loc.City)
+			assert.Equal(t, tt.want, loc.Point)
+		})
+	}
+
+	t.Run("Fail through the advertisement body", func(t *testing.T) {
+		ad := &advertisement.Model{}
+		err := json.Unmarshal([]byte(`{"title":"Fake title","location":{"city":"Santos","country":"BR"}}`), ad)
+		assert.Equal(t, http.StatusBadRequest, apierrors.Parse(err).StatusCode())
+	})
+}
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
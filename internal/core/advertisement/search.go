@@ -0,0 +1,94 @@
+package advertisement
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"go-api/internal/core/field"
+	"go-api/pkg/apierrors"
+	"go-api/pkg/money"
+)
+
+const maxSearchTextLength = 200
+
+// ListQuery model store the filters of the advertisement listing. Field
+// filters only make sense within a category, so they require CategoryID.
+type ListQuery struct {
+	CategoryID *uuid.UUID
+	Fields     []*field.Filter
+}
+
+// SearchQuery model store the full-text search terms and filters
+type SearchQuery struct {
+	Text       string
+	CategoryID *uuid.UUID
+	Status     Status
+	MinPrice   *int64
+	MaxPrice   *int64
+	Currency   string
+}
+
+// SearchResult model store an advertisement matched by a search with its
+// rank and a highlighted snippet of the description. The snippet is HTML:
+// the description text is escaped and the matches are wrapped in <mark>
+// tags, so clients can render it as is but must not escape it again.
+type SearchResult struct {
+	Model
+	Rank    float64 `json:"rank" db:"rank"`
+	Snippet string  `json:"snippet" db:"snippet"`
+}
+
+// MarshalJSON keeps the rank and the snippet next to the advertisement
+// fields, which the promoted Model.MarshalJSON would drop
+func (r SearchResult) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		modelJSON
+		Rank    float64 `json:"rank"`
+		Snippet string  `json:"snippet"`
+	}{r.Model.toJSON(), r.Rank, r.Snippet})
+}
+
+// SearchList model store search result pages
+type SearchList struct {
+	TotalCount int              `json:"total_count"`
+	TotalPages int              `json:"total_pages"`
+	Page       int              `json:"page"`
+	Size       int              `json:"size"`
+	HasMore    bool             `json:"has_more"`
+	Results    *[]*SearchResult `json:"results"`
+}
+
+// Validate checks the search terms and filters
+func (q *SearchQuery) Validate() error {
+	q.Text = strings.TrimSpace(q.Text)
+	if q.Text == "" {
+		return apierrors.BadRequest("search text is required")
+	}
+
+	if len(q.Text) > maxSearchTextLength {
+		return apierrors.BadRequest("search text is too long")
+	}
+
+	if q.Status != "" && !q.Status.IsValid() {
+		return apierrors.BadRequest("invalid status")
+	}
+
+	if q.MinPrice != nil && q.MaxPrice != nil && *q.MinPrice > *q.MaxPrice {
+		return apierrors.BadRequest("min_price cannot be greater than max_price")
+	}
+
+	// Prices are in minor units of their currency, so bounds only compare
+	// advertisements of one currency
+	q.Currency = strings.ToUpper(q.Currency)
+	if (q.MinPrice != nil || q.MaxPrice != nil) && q.Currency == "" {
+		return apierrors.BadRequest("currency is required to filter by price")
+	}
+
+	if q.Currency != "" && !money.IsValidCurrency(q.Currency) {
+		return apierrors.BadRequest("invalid currency")
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package advertisement
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+
+	"go-api/internal/core/user"
+	"go-api/pkg/apierrors"
+)
+
+const shareTokenBytes = 32
+
+// Visibility of an advertisement, mirroring the ADVISIBILITY enum. Public
+// advertisements show up in listings and searches, unlisted ones are only
+// reachable through their share token and invite only ones are only seen by
+// the invited workers.
+type Visibility string
+
+const (
+	VisibilityPublic     Visibility = "public"
+	VisibilityUnlisted   Visibility = "unlisted"
+	VisibilityInviteOnly Visibility = "invite_only"
+)
+
+// IsValid reports whether the visibility is one of the ADVISIBILITY values
+func (v Visibility) IsValid() bool {
+	switch v {
+	case VisibilityPublic, VisibilityUnlisted, VisibilityInviteOnly:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewShareToken returns an unguessable URL safe token for unlisted advertisements
+func NewShareToken() (string, error) {
+	b := make([]byte, shareTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// HasShareToken reports whether the token opens the unlisted advertisement
+func (m *Model) HasShareToken(token string) bool {
+	if m.Visibility != VisibilityUnlisted || m.ShareToken == nil || token == "" {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(*m.ShareToken), []byte(token)) == 1
+}
+
+// CheckVisible returns a not found error unless the user in the context may
+// see the advertisement. Besides public advertisements and unlisted ones
+// opened with their share token, users see the advertisements they own,
+// every advertisement when they are admins, and the ones they applied or
+// were invited to.
+func CheckVisible(ctx context.Context, repo Repository, ad *Model, shareToken string) error {
+	if ad.Visibility == VisibilityPublic || ad.HasShareToken(shareToken) {
+		return nil
+	}
+
+	usr, err := user.GetUserFromCtx(ctx)
+	if err != nil {
+		return apierrors.NotFound()
+	}
+
+	if usr.CanActOn(ad.CostumerID, user.PermAdvertisementManage) {
+		return nil
+	}
+
+	if usr.HasRole(user.RoleWorker) {
+		participant, err := repo.IsParticipant(ctx, ad.ID, usr.ID)
+		if err != nil {
+			return err
+		}
+		if participant {
+			return nil
+		}
+	}
+
+	return apierrors.NotFound()
+}
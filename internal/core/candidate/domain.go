@@ -0,0 +1,43 @@
+package candidate
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"go-api/internal/core/advertisement"
+	"go-api/pkg/utils"
+)
+
+type Handlers interface {
+	Apply() gin.HandlerFunc
+	Withdraw() gin.HandlerFunc
+	Select() gin.HandlerFunc
+	GetByAdvertisement() gin.HandlerFunc
+	CreateOffer() gin.HandlerFunc
+	AcceptOffer() gin.HandlerFunc
+	GetOffers() gin.HandlerFunc
+}
+
+type Repository interface {
+	Create(ctx context.Context, candidate *Model, bid *Offer) (*Model, error)
+	Delete(ctx context.Context, adID, workerID uuid.UUID) error
+	GetByID(ctx context.Context, adID, workerID uuid.UUID) (*Model, error)
+	Select(ctx context.Context, adID, workerID uuid.UUID, sel *Selection) (*advertisement.Model, error)
+	GetByAdvertisement(ctx context.Context, adID uuid.UUID, pq *utils.PaginationQuery) (*List, error)
+	CreateOffer(ctx context.Context, offer *Offer) (*Offer, error)
+	AcceptOffer(ctx context.Context, adID, workerID, offerID uuid.UUID) (*Offer, error)
+	GetOffers(ctx context.Context, adID, workerID uuid.UUID) ([]*Offer, error)
+	GetLatestOffer(ctx context.Context, adID, workerID uuid.UUID) (*Offer, error)
+}
+
+type UseCase interface {
+	Apply(ctx context.Context, adID uuid.UUID, app *Application) (*Model, error)
+	Withdraw(ctx context.Context, adID uuid.UUID) error
+	Select(ctx context.Context, adID, workerID uuid.UUID) (*advertisement.Model, error)
+	GetByAdvertisement(ctx context.Context, adID uuid.UUID, pq *utils.PaginationQuery) (*List, error)
+	CreateOffer(ctx context.Context, adID, workerID uuid.UUID, proposal *Proposal) (*Offer, error)
+	AcceptOffer(ctx context.Context, adID, workerID, offerID uuid.UUID) (*Offer, error)
+	GetOffers(ctx context.Context, adID, workerID uuid.UUID) ([]*Offer, error)
+}
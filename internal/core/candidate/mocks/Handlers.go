@@ -0,0 +1,140 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package candidatemock
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Handlers is an autogenerated mock type for the Handlers type
+type Handlers struct {
+	mock.Mock
+}
+
+// AcceptOffer provides a mock function with given fields:
+func (_m *Handlers) AcceptOffer() gin.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 gin.HandlerFunc
+	if rf, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+
+	return r0
+}
+
+// Apply provides a mock function with given fields:
+func (_m *Handlers) Apply() gin.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 gin.HandlerFunc
+	if rf, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+
+	return r0
+}
+
+// CreateOffer provides a mock function with given fields:
+func (_m *Handlers) CreateOffer() gin.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 gin.HandlerFunc
+	if rf, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+
+	return r0
+}
+
+// GetByAdvertisement provides a mock function with given fields:
+func (_m *Handlers) GetByAdvertisement() gin.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 gin.HandlerFunc
+	if rf, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+
+	return r0
+}
+
+// GetOffers provides a mock function with given fields:
+func (_m *Handlers) GetOffers() gin.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 gin.HandlerFunc
+	if rf, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+
+	return r0
+}
+
+// Select provides a mock function with given fields:
+func (_m *Handlers) Select() gin.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 gin.HandlerFunc
+	if rf, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+
+	return r0
+}
+
+// Withdraw provides a mock function with given fields:
+func (_m *Handlers) Withdraw() gin.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 gin.HandlerFunc
+	if rf, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewHandlers interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewHandlers creates a new instance of Handlers. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewHandlers(t mockConstructorTestingTNewHandlers) *Handlers {
+	mock := &Handlers{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
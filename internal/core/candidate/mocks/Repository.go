@@ -0,0 +1,258 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package candidatemock
+
+import (
+	advertisement "go-api/internal/core/advertisement"
+	candidate "go-api/internal/core/candidate"
+
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	utils "go-api/pkg/utils"
+
+	uuid "github.com/google/uuid"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+// AcceptOffer provides a mock function with given fields: ctx, adID, workerID, offerID
+func (_m *Repository) AcceptOffer(ctx context.Context, adID uuid.UUID, workerID uuid.UUID, offerID uuid.UUID) (*candidate.Offer, error) {
+	ret := _m.Called(ctx, adID, workerID, offerID)
+
+	var r0 *candidate.Offer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*candidate.Offer, error)); ok {
+		return rf(ctx, adID, workerID, offerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) *candidate.Offer); ok {
+		r0 = rf(ctx, adID, workerID, offerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*candidate.Offer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, adID, workerID, offerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: ctx, _a1, bid
+func (_m *Repository) Create(ctx context.Context, _a1 *candidate.Model, bid *candidate.Offer) (*candidate.Model, error) {
+	ret := _m.Called(ctx, _a1, bid)
+
+	var r0 *candidate.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *candidate.Model, *candidate.Offer) (*candidate.Model, error)); ok {
+		return rf(ctx, _a1, bid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *candidate.Model, *candidate.Offer) *candidate.Model); ok {
+		r0 = rf(ctx, _a1, bid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*candidate.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *candidate.Model, *candidate.Offer) error); ok {
+		r1 = rf(ctx, _a1, bid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateOffer provides a mock function with given fields: ctx, offer
+func (_m *Repository) CreateOffer(ctx context.Context, offer *candidate.Offer) (*candidate.Offer, error) {
+	ret := _m.Called(ctx, offer)
+
+	var r0 *candidate.Offer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *candidate.Offer) (*candidate.Offer, error)); ok {
+		return rf(ctx, offer)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *candidate.Offer) *candidate.Offer); ok {
+		r0 = rf(ctx, offer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*candidate.Offer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *candidate.Offer) error); ok {
+		r1 = rf(ctx, offer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, adID, workerID
+func (_m *Repository) Delete(ctx context.Context, adID uuid.UUID, workerID uuid.UUID) error {
+	ret := _m.Called(ctx, adID, workerID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, adID, workerID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByAdvertisement provides a mock function with given fields: ctx, adID, pq
+func (_m *Repository) GetByAdvertisement(ctx context.Context, adID uuid.UUID, pq *utils.PaginationQuery) (*candidate.List, error) {
+	ret := _m.Called(ctx, adID, pq)
+
+	var r0 *candidate.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *utils.PaginationQuery) (*candidate.List, error)); ok {
+		return rf(ctx, adID, pq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *utils.PaginationQuery) *candidate.List); ok {
+		r0 = rf(ctx, adID, pq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*candidate.List)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *utils.PaginationQuery) error); ok {
+		r1 = rf(ctx, adID, pq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByID provides a mock function with given fields: ctx, adID, workerID
+func (_m *Repository) GetByID(ctx context.Context, adID uuid.UUID, workerID uuid.UUID) (*candidate.Model, error) {
+	ret := _m.Called(ctx, adID, workerID)
+
+	var r0 *candidate.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*candidate.Model, error)); ok {
+		return rf(ctx, adID, workerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *candidate.Model); ok {
+		r0 = rf(ctx, adID, workerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*candidate.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, adID, workerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLatestOffer provides a mock function with given fields: ctx, adID, workerID
+func (_m *Repository) GetLatestOffer(ctx context.Context, adID uuid.UUID, workerID uuid.UUID) (*candidate.Offer, error) {
+	ret := _m.Called(ctx, adID, workerID)
+
+	var r0 *candidate.Offer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*candidate.Offer, error)); ok {
+		return rf(ctx, adID, workerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *candidate.Offer); ok {
+		r0 = rf(ctx, adID, workerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*candidate.Offer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, adID, workerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOffers provides a mock function with given fields: ctx, adID, workerID
+func (_m *Repository) GetOffers(ctx context.Context, adID uuid.UUID, workerID uuid.UUID) ([]*candidate.Offer, error) {
+	ret := _m.Called(ctx, adID, workerID)
+
+	var r0 []*candidate.Offer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]*candidate.Offer, error)); ok {
+		return rf(ctx, adID, workerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []*candidate.Offer); ok {
+		r0 = rf(ctx, adID, workerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*candidate.Offer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, adID, workerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Select provides a mock function with given fields: ctx, adID, workerID, sel
+func (_m *Repository) Select(ctx context.Context, adID uuid.UUID, workerID uuid.UUID, sel *candidate.Selection) (*advertisement.Model, error) {
+	ret := _m.Called(ctx, adID, workerID, sel)
+
+	var r0 *advertisement.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *candidate.Selection) (*advertisement.Model, error)); ok {
+		return rf(ctx, adID, workerID, sel)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *candidate.Selection) *advertisement.Model); ok {
+		r0 = rf(ctx, adID, workerID, sel)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*advertisement.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, *candidate.Selection) error); ok {
+		r1 = rf(ctx, adID, workerID, sel)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRepository(t mockConstructorTestingTNewRepository) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
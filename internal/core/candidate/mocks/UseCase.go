@@ -0,0 +1,206 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package candidatemock
+
+import (
+	advertisement "go-api/internal/core/advertisement"
+	candidate "go-api/internal/core/candidate"
+
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	utils "go-api/pkg/utils"
+
+	uuid "github.com/google/uuid"
+)
+
+// UseCase is an autogenerated mock type for the UseCase type
+type UseCase struct {
+	mock.Mock
+}
+
+// AcceptOffer provides a mock function with given fields: ctx, adID, workerID, offerID
+func (_m *UseCase) AcceptOffer(ctx context.Context, adID uuid.UUID, workerID uuid.UUID, offerID uuid.UUID) (*candidate.Offer, error) {
+	ret := _m.Called(ctx, adID, workerID, offerID)
+
+	var r0 *candidate.Offer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*candidate.Offer, error)); ok {
+		return rf(ctx, adID, workerID, offerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) *candidate.Offer); ok {
+		r0 = rf(ctx, adID, workerID, offerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*candidate.Offer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, adID, workerID, offerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Apply provides a mock function with given fields: ctx, adID, app
+func (_m *UseCase) Apply(ctx context.Context, adID uuid.UUID, app *candidate.Application) (*candidate.Model, error) {
+	ret := _m.Called(ctx, adID, app)
+
+	var r0 *candidate.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *candidate.Application) (*candidate.Model, error)); ok {
+		return rf(ctx, adID, app)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *candidate.Application) *candidate.Model); ok {
+		r0 = rf(ctx, adID, app)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*candidate.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *candidate.Application) error); ok {
+		r1 = rf(ctx, adID, app)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateOffer provides a mock function with given fields: ctx, adID, workerID, proposal
+func (_m *UseCase) CreateOffer(ctx context.Context, adID uuid.UUID, workerID uuid.UUID, proposal *candidate.Proposal) (*candidate.Offer, error) {
+	ret := _m.Called(ctx, adID, workerID, proposal)
+
+	var r0 *candidate.Offer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *candidate.Proposal) (*candidate.Offer, error)); ok {
+		return rf(ctx, adID, workerID, proposal)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *candidate.Proposal) *candidate.Offer); ok {
+		r0 = rf(ctx, adID, workerID, proposal)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*candidate.Offer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, *candidate.Proposal) error); ok {
+		r1 = rf(ctx, adID, workerID, proposal)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByAdvertisement provides a mock function with given fields: ctx, adID, pq
+func (_m *UseCase) GetByAdvertisement(ctx context.Context, adID uuid.UUID, pq *utils.PaginationQuery) (*candidate.List, error) {
+	ret := _m.Called(ctx, adID, pq)
+
+	var r0 *candidate.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *utils.PaginationQuery) (*candidate.List, error)); ok {
+		return rf(ctx, adID, pq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *utils.PaginationQuery) *candidate.List); ok {
+		r0 = rf(ctx, adID, pq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*candidate.List)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *utils.PaginationQuery) error); ok {
+		r1 = rf(ctx, adID, pq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOffers provides a mock function with given fields: ctx, adID, workerID
+func (_m *UseCase) GetOffers(ctx context.Context, adID uuid.UUID, workerID uuid.UUID) ([]*candidate.Offer, error) {
+	ret := _m.Called(ctx, adID, workerID)
+
+	var r0 []*candidate.Offer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]*candidate.Offer, error)); ok {
+		return rf(ctx, adID, workerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []*candidate.Offer); ok {
+		r0 = rf(ctx, adID, workerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*candidate.Offer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, adID, workerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Select provides a mock function with given fields: ctx, adID, workerID
+func (_m *UseCase) Select(ctx context.Context, adID uuid.UUID, workerID uuid.UUID) (*advertisement.Model, error) {
+	ret := _m.Called(ctx, adID, workerID)
+
+	var r0 *advertisement.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*advertisement.Model, error)); ok {
+		return rf(ctx, adID, workerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *advertisement.Model); ok {
+		r0 = rf(ctx, adID, workerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*advertisement.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, adID, workerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Withdraw provides a mock function with given fields: ctx, adID
+func (_m *UseCase) Withdraw(ctx context.Context, adID uuid.UUID) error {
+	ret := _m.Called(ctx, adID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, adID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewUseCase interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewUseCase creates a new instance of UseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewUseCase(t mockConstructorTestingTNewUseCase) *UseCase {
+	mock := &UseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,53 @@
+package candidate
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"go-api/internal/core/profile"
+)
+
+// Status of an application, mirroring the CANDIDATESTATUS enum
+type Status string
+
+const (
+	StatusApplied  Status = "applied"
+	StatusSelected Status = "selected"
+	StatusRejected Status = "rejected"
+)
+
+// Orders accepted when listing the candidates of an advertisement
+const (
+	OrderByCreatedAt  = "created_at"
+	OrderByReputation = "reputation"
+)
+
+// Model model store a worker application to an advertisement
+type Model struct {
+	AdvertisementID uuid.UUID       `json:"advertisement_id" db:"advertisement_id"`
+	WorkerID        uuid.UUID       `json:"worker_id" db:"worker_id"`
+	Status          Status          `json:"status" db:"status"`
+	OfferAmount     *int64          `json:"offer_amount,omitempty" db:"offer_amount"`
+	Profile         *profile.Public `json:"profile,omitempty" db:"profile"`
+	CreatedAt       time.Time       `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time       `json:"updated_at" db:"updated_at"`
+}
+
+// Application model store the data a worker sends when applying. The share
+// token is required to apply to unlisted advertisements and the optional bid
+// proposes a price other than the advertisement one.
+type Application struct {
+	ShareToken string    `json:"share_token"`
+	Bid        *Proposal `json:"bid"`
+}
+
+// List model store candidate pages
+type List struct {
+	TotalCount int       `json:"total_count"`
+	TotalPages int       `json:"total_pages"`
+	Page       int       `json:"page"`
+	Size       int       `json:"size"`
+	HasMore    bool      `json:"has_more"`
+	Candidates *[]*Model `json:"candidates"`
+}
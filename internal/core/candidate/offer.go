@@ -0,0 +1,79 @@
+package candidate
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"go-api/pkg/apierrors"
+	"go-api/pkg/money"
+)
+
+const maxMessageLength = 1000
+
+// OfferStatus of an offer, mirroring the OFFERSTATUS enum
+type OfferStatus string
+
+const (
+	OfferPending   OfferStatus = "pending"
+	OfferAccepted  OfferStatus = "accepted"
+	OfferCountered OfferStatus = "countered"
+)
+
+// Offer model store a price proposed by the costumer or the candidate while
+// negotiating an advertisement. The bid sent when applying is the first
+// offer of the thread.
+type Offer struct {
+	ID              uuid.UUID   `json:"id" db:"id"`
+	AdvertisementID uuid.UUID   `json:"advertisement_id" db:"advertisement_id"`
+	WorkerID        uuid.UUID   `json:"worker_id" db:"worker_id"`
+	AuthorID        uuid.UUID   `json:"author_id" db:"author_id"`
+	Amount          int64       `json:"amount" db:"amount"`
+	Currency        string      `json:"currency" db:"currency"`
+	Message         string      `json:"message" db:"message"`
+	Status          OfferStatus `json:"status" db:"status"`
+	CreatedAt       time.Time   `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time   `json:"updated_at" db:"updated_at"`
+}
+
+// Selection model store the price the advertisement is assigned for. OfferID
+// is the pending bid of the worker the costumer accepts by selecting them.
+type Selection struct {
+	Price   *int64
+	OfferID *uuid.UUID
+}
+
+// Proposal model store a price sent by either side of the negotiation. The
+// price is read in minor units or as a decimal string and its currency must
+// be the advertisement one.
+type Proposal struct {
+	Price   *money.Money `json:"price"`
+	Message string       `json:"message"`
+}
+
+// Offer validates the proposal and returns the offer it makes
+func (p *Proposal) Offer(currency string) (*Offer, error) {
+	if p.Price == nil {
+		return nil, apierrors.BadRequest("price is required")
+	}
+
+	if p.Price.Currency != currency {
+		return nil, apierrors.BadRequest("currency must match the advertisement currency")
+	}
+
+	if p.Price.IsNegative() {
+		return nil, apierrors.BadRequest("price must not be negative")
+	}
+
+	message := strings.TrimSpace(p.Message)
+	if len(message) > maxMessageLength {
+		return nil, apierrors.BadRequest("message is too long")
+	}
+
+	return &Offer{
+		Amount:   p.Price.Amount,
+		Currency: p.Price.Currency,
+		Message:  message,
+	}, nil
+}
@@ -0,0 +1,34 @@
+package category
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"go-api/pkg/utils"
+)
+
+type Handlers interface {
+	Create() gin.HandlerFunc
+	Update() gin.HandlerFunc
+	Delete() gin.HandlerFunc
+	GetByID() gin.HandlerFunc
+	GetCategories() gin.HandlerFunc
+}
+
+type Repository interface {
+	Create(ctx context.Context, category *Model) (*Model, error)
+	Update(ctx context.Context, category *Model) (*Model, error)
+	Delete(ctx context.Context, categoryID uuid.UUID) error
+	GetByID(ctx context.Context, categoryID uuid.UUID) (*Model, error)
+	GetCategories(ctx context.Context, pq *utils.PaginationQuery) (*List, error)
+}
+
+type UseCase interface {
+	Create(ctx context.Context, category *Model) (*Model, error)
+	Update(ctx context.Context, category *Model) (*Model, error)
+	Delete(ctx context.Context, categoryID uuid.UUID) error
+	GetByID(ctx context.Context, categoryID uuid.UUID) (*Model, error)
+	GetCategories(ctx context.Context, pq *utils.PaginationQuery) (*List, error)
+}
@@ -0,0 +1,108 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package categorymock
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Handlers is an autogenerated mock type for the Handlers type
+type Handlers struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields:
+func (_m *Handlers) Create() gin.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 gin.HandlerFunc
+	if rf, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields:
+func (_m *Handlers) Delete() gin.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 gin.HandlerFunc
+	if rf, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+
+	return r0
+}
+
+// GetByID provides a mock function with given fields:
+func (_m *Handlers) GetByID() gin.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 gin.HandlerFunc
+	if rf, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+
+	return r0
+}
+
+// GetCategories provides a mock function with given fields:
+func (_m *Handlers) GetCategories() gin.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 gin.HandlerFunc
+	if rf, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields:
+func (_m *Handlers) Update() gin.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 gin.HandlerFunc
+	if rf, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewHandlers interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewHandlers creates a new instance of Handlers. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewHandlers(t mockConstructorTestingTNewHandlers) *Handlers {
+	mock := &Handlers{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
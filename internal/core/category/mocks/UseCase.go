@@ -0,0 +1,152 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package categorymock
+
+import (
+	context "context"
+	category "go-api/internal/core/category"
+
+	mock "github.com/stretchr/testify/mock"
+
+	utils "go-api/pkg/utils"
+
+	uuid "github.com/google/uuid"
+)
+
+// UseCase is an autogenerated mock type for the UseCase type
+type UseCase struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, _a1
+func (_m *UseCase) Create(ctx context.Context, _a1 *category.Model) (*category.Model, error) {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 *category.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *category.Model) (*category.Model, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *category.Model) *category.Model); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*category.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *category.Model) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, categoryID
+func (_m *UseCase) Delete(ctx context.Context, categoryID uuid.UUID) error {
+	ret := _m.Called(ctx, categoryID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, categoryID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByID provides a mock function with given fields: ctx, categoryID
+func (_m *UseCase) GetByID(ctx context.Context, categoryID uuid.UUID) (*category.Model, error) {
+	ret := _m.Called(ctx, categoryID)
+
+	var r0 *category.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*category.Model, error)); ok {
+		return rf(ctx, categoryID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *category.Model); ok {
+		r0 = rf(ctx, categoryID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*category.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, categoryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCategories provides a mock function with given fields: ctx, pq
+func (_m *UseCase) GetCategories(ctx context.Context, pq *utils.PaginationQuery) (*category.List, error) {
+	ret := _m.Called(ctx, pq)
+
+	var r0 *category.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *utils.PaginationQuery) (*category.List, error)); ok {
+		return rf(ctx, pq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *utils.PaginationQuery) *category.List); ok {
+		r0 = rf(ctx, pq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*category.List)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *utils.PaginationQuery) error); ok {
+		r1 = rf(ctx, pq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, _a1
+func (_m *UseCase) Update(ctx context.Context, _a1 *category.Model) (*category.Model, error) {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 *category.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *category.Model) (*category.Model, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *category.Model) *category.Model); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*category.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *category.Model) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewUseCase interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewUseCase creates a new instance of UseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewUseCase(t mockConstructorTestingTNewUseCase) *UseCase {
+	mock := &UseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,26 @@
+package category
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Model model store category data
+type Model struct {
+	ID          uuid.UUID `json:"id" db:"id"`
+	Name        string    `json:"name" db:"name"`
+	Description string    `json:"description" db:"description"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// List model store category pages
+type List struct {
+	TotalCount int       `json:"total_count"`
+	TotalPages int       `json:"total_pages"`
+	Page       int       `json:"page"`
+	Size       int       `json:"size"`
+	HasMore    bool      `json:"has_more"`
+	Categories *[]*Model `json:"categories"`
+}
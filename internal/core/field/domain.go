@@ -0,0 +1,32 @@
+package field
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type Handlers interface {
+	Create() gin.HandlerFunc
+	Update() gin.HandlerFunc
+	Delete() gin.HandlerFunc
+	GetByID() gin.HandlerFunc
+	GetByCategory() gin.HandlerFunc
+}
+
+type Repository interface {
+	Create(ctx context.Context, field *Model) (*Model, error)
+	Update(ctx context.Context, field *Model) (*Model, error)
+	Delete(ctx context.Context, categoryID, fieldID uuid.UUID) error
+	GetByID(ctx context.Context, categoryID, fieldID uuid.UUID) (*Model, error)
+	GetByCategory(ctx context.Context, categoryID uuid.UUID) ([]*Model, error)
+}
+
+type UseCase interface {
+	Create(ctx context.Context, field *Model) (*Model, error)
+	Update(ctx context.Context, field *Model) (*Model, error)
+	Delete(ctx context.Context, categoryID, fieldID uuid.UUID) error
+	GetByID(ctx context.Context, categoryID, fieldID uuid.UUID) (*Model, error)
+	GetByCategory(ctx context.Context, categoryID uuid.UUID) ([]*Model, error)
+}
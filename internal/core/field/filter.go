@@ -0,0 +1,158 @@
+package field
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"go-api/pkg/apierrors"
+)
+
+// Operator compares a stored field value with a filter value
+type Operator string
+
+const (
+	OperatorEq       Operator = "eq"
+	OperatorGt       Operator = "gt"
+	OperatorGte      Operator = "gte"
+	OperatorLt       Operator = "lt"
+	OperatorLte      Operator = "lte"
+	OperatorContains Operator = "contains"
+)
+
+// operators lists the operators each field type accepts. Selects only match
+// exact choices, numbers and dates compare as ranges and text can be
+// searched for a substring.
+var operators = map[Type][]Operator{
+	TypeText:         {OperatorEq, OperatorContains},
+	TypeNumber:       {OperatorEq, OperatorGt, OperatorGte, OperatorLt, OperatorLte},
+	TypeInteger:      {OperatorEq, OperatorGt, OperatorGte, OperatorLt, OperatorLte},
+	TypeMoney:        {OperatorEq, OperatorGt, OperatorGte, OperatorLt, OperatorLte},
+	TypeDate:         {OperatorEq, OperatorGt, OperatorGte, OperatorLt, OperatorLte},
+	TypeBoolean:      {OperatorEq},
+	TypeSingleSelect: {OperatorEq},
+	TypeMultiSelect:  {OperatorContains},
+}
+
+// Filter is a condition on a field value, written as name:operator:value.
+// FieldID and Type are filled once the filter is resolved against the
+// fields of a category.
+type Filter struct {
+	Name     string
+	Operator Operator
+	Value    string
+	FieldID  uuid.UUID
+	Type     Type
+}
+
+// ParseFilter reads a filter written as name:operator:value. The value may
+// itself contain colons.
+func ParseFilter(s string) (*Filter, error) {
+	parts := strings.SplitN(s, ":", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" {
+		return nil, apierrors.BadRequest(fmt.Sprintf("invalid filter %q, expected name:operator:value", s))
+	}
+
+	return &Filter{
+		Name:     parts[0],
+		Operator: Operator(parts[1]),
+		Value:    parts[2],
+	}, nil
+}
+
+// ResolveFilters checks every filter against the field definitions of a
+// category and returns them with the field identified and the value in the
+// form the stored values are compared with. Like ValidateValues it reports
+// every invalid filter at once.
+func ResolveFilters(fields []*Model, filters []*Filter) ([]*Filter, error) {
+	byName := make(map[string]*Model, len(fields))
+	for _, f := range fields {
+		byName[f.Name] = f
+	}
+
+	resolved := make([]*Filter, 0, len(filters))
+	invalid := make([]ValueError, 0)
+	for _, filter := range filters {
+		f, ok := byName[filter.Name]
+		if !ok {
+			invalid = append(invalid, ValueError{Field: filter.Name, Message: "field does not belong to the category"})
+			continue
+		}
+
+		value, err := f.filterValue(filter.Operator, filter.Value)
+		if err != nil {
+			invalid = append(invalid, ValueError{Field: filter.Name, Message: err.Error()})
+			continue
+		}
+
+		resolved = append(resolved, &Filter{
+			Name:     f.Name,
+			Operator: filter.Operator,
+			Value:    value,
+			FieldID:  f.ID,
+			Type:     f.Type,
+		})
+	}
+
+	if len(invalid) > 0 {
+		sort.SliceStable(invalid, func(i, j int) bool { return invalid[i].Field < invalid[j].Field })
+		return nil, apierrors.BadRequest("invalid filters").WithDetails(invalid)
+	}
+
+	return resolved, nil
+}
+
+// filterValue checks the operator is allowed for the field type and parses
+// the filter value
+func (m *Model) filterValue(op Operator, value string) (string, error) {
+	if !m.accepts(op) {
+		return "", fmt.Errorf("operator %q is not supported for %s fields", op, m.Type)
+	}
+
+	if len(value) > MaxValueLength {
+		return "", errors.New("value is too long")
+	}
+
+	switch m.Type {
+	case TypeNumber, TypeInteger, TypeMoney:
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsNaN(n) || math.IsInf(n, 0) {
+			return "", errors.New("must be a number")
+		}
+		return strconv.FormatFloat(n, 'f', -1, 64), nil
+	case TypeDate:
+		if _, err := time.Parse(DateLayout, value); err != nil {
+			return "", errors.New("must be a date formatted as YYYY-MM-DD")
+		}
+		return value, nil
+	case TypeBoolean:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", errors.New("must be a boolean")
+		}
+		return strconv.FormatBool(b), nil
+	case TypeSingleSelect, TypeMultiSelect:
+		if !m.allows(value) {
+			return "", fmt.Errorf("%q is not an allowed value", value)
+		}
+		return value, nil
+	default:
+		return value, nil
+	}
+}
+
+func (m *Model) accepts(op Operator) bool {
+	for _, allowed := range operators[m.Type] {
+		if allowed == op {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,86 @@
+package field_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"go-api/internal/core/field"
+	"go-api/pkg/apierrors"
+)
+
+func TestParseFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  string
+		want    *field.Filter
+		wantErr bool
+	}{
+		{"Success", "hours:gte:4", &field.Filter{Name: "hours", Operator: field.OperatorGte, Value: "4"}, false},
+		{"Success with colon in value", "pickup:eq:10:30", &field.Filter{Name: "pickup", Operator: field.OperatorEq, Value: "10:30"}, false},
+		{"Success with empty value", "notes:eq:", &field.Filter{Name: "notes", Operator: field.OperatorEq}, false},
+		{"Fail without value", "hours:gte", nil, true},
+		{"Fail without operator", "hours::4", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := field.ParseFilter(tt.filter)
+			if tt.wantErr {
+				assert.Equal(t, http.StatusBadRequest, apierrors.Parse(err).StatusCode())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestResolveFilters(t *testing.T) {
+	fields := []*field.Model{
+		{ID: uuid.New(), Name: "hours", Type: field.TypeInteger},
+		{ID: uuid.New(), Name: "vehicle_type", Type: field.TypeSingleSelect, Options: field.Options{Values: []string{"truck", "van"}}},
+		{ID: uuid.New(), Name: "start", Type: field.TypeDate},
+		{ID: uuid.New(), Name: "tools", Type: field.TypeMultiSelect, Options: field.Options{Values: []string{"drill", "saw"}}},
+		{ID: uuid.New(), Name: "insured", Type: field.TypeBoolean},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		got, err := field.ResolveFilters(fields, []*field.Filter{
+			{Name: "hours", Operator: field.OperatorGte, Value: "4.0"},
+			{Name: "vehicle_type", Operator: field.OperatorEq, Value: "truck"},
+			{Name: "start", Operator: field.OperatorLt, Value: "2024-01-31"},
+			{Name: "tools", Operator: field.OperatorContains, Value: "saw"},
+			{Name: "insured", Operator: field.OperatorEq, Value: "1"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []*field.Filter{
+			{Name: "hours", Operator: field.OperatorGte, Value: "4", FieldID: fields[0].ID, Type: field.TypeInteger},
+			{Name: "vehicle_type", Operator: field.OperatorEq, Value: "truck", FieldID: fields[1].ID, Type: field.TypeSingleSelect},
+			{Name: "start", Operator: field.OperatorLt, Value: "2024-01-31", FieldID: fields[2].ID, Type: field.TypeDate},
+			{Name: "tools", Operator: field.OperatorContains, Value: "saw", FieldID: fields[3].ID, Type: field.TypeMultiSelect},
+			{Name: "insured", Operator: field.OperatorEq, Value: "true", FieldID: fields[4].ID, Type: field.TypeBoolean},
+		}, got)
+	})
+
+	t.Run("Fail with every invalid filter", func(t *testing.T) {
+		got, err := field.ResolveFilters(fields, []*field.Filter{
+			{Name: "hours", Operator: field.OperatorContains, Value: "4"},
+			{Name: "vehicle_type", Operator: field.OperatorGt, Value: "truck"},
+			{Name: "start", Operator: field.OperatorEq, Value: "31/01/2024"},
+			{Name: "color", Operator: field.OperatorEq, Value: "red"},
+		})
+		assert.Nil(t, got)
+		apiErr := apierrors.Parse(err)
+		assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode())
+		assert.Equal(t, []field.ValueError{
+			{Field: "color", Message: "field does not belong to the category"},
+			{Field: "hours", Message: `operator "contains" is not supported for integer fields`},
+			{Field: "start", Message: "must be a date formatted as YYYY-MM-DD"},
+			{Field: "vehicle_type", Message: `operator "gt" is not supported for single-select fields`},
+		}, apiErr.Details)
+	})
+}
@@ -0,0 +1,150 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package fieldmock
+
+import (
+	context "context"
+	field "go-api/internal/core/field"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, _a1
+func (_m *Repository) Create(ctx context.Context, _a1 *field.Model) (*field.Model, error) {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 *field.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *field.Model) (*field.Model, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *field.Model) *field.Model); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*field.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *field.Model) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, categoryID, fieldID
+func (_m *Repository) Delete(ctx context.Context, categoryID uuid.UUID, fieldID uuid.UUID) error {
+	ret := _m.Called(ctx, categoryID, fieldID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, categoryID, fieldID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByCategory provides a mock function with given fields: ctx, categoryID
+func (_m *Repository) GetByCategory(ctx context.Context, categoryID uuid.UUID) ([]*field.Model, error) {
+	ret := _m.Called(ctx, categoryID)
+
+	var r0 []*field.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*field.Model, error)); ok {
+		return rf(ctx, categoryID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*field.Model); ok {
+		r0 = rf(ctx, categoryID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*field.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, categoryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByID provides a mock function with given fields: ctx, categoryID, fieldID
+func (_m *Repository) GetByID(ctx context.Context, categoryID uuid.UUID, fieldID uuid.UUID) (*field.Model, error) {
+	ret := _m.Called(ctx, categoryID, fieldID)
+
+	var r0 *field.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*field.Model, error)); ok {
+		return rf(ctx, categoryID, fieldID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *field.Model); ok {
+		r0 = rf(ctx, categoryID, fieldID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*field.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, categoryID, fieldID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, _a1
+func (_m *Repository) Update(ctx context.Context, _a1 *field.Model) (*field.Model, error) {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 *field.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *field.Model) (*field.Model, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *field.Model) *field.Model); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*field.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *field.Model) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRepository(t mockConstructorTestingTNewRepository) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,46 @@
+package field
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"go-api/pkg/apierrors"
+)
+
+const maxNameLength = 64
+
+// Model model store a category field definition
+type Model struct {
+	ID          uuid.UUID `json:"id" db:"id"`
+	CategoryID  uuid.UUID `json:"category_id" db:"category_id"`
+	Name        string    `json:"name" db:"name"`
+	Description string    `json:"description" db:"description"`
+	Type        Type      `json:"type" db:"type"`
+	Options     Options   `json:"options" db:"options"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Validate checks the field name, type and options
+func (m *Model) Validate() error {
+	m.Name = strings.TrimSpace(m.Name)
+	if m.Name == "" {
+		return apierrors.BadRequest("name is required")
+	}
+
+	if len(m.Name) > maxNameLength {
+		return apierrors.BadRequest("name is too long")
+	}
+
+	if !m.Type.IsValid() {
+		return apierrors.BadRequest("invalid field type", string(m.Type))
+	}
+
+	if err := m.Options.validate(m.Type); err != nil {
+		return apierrors.BadRequest("invalid options", err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,235 @@
+package field
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"time"
+
+	"go-api/pkg/apierrors"
+)
+
+// Type of the values a field accepts
+type Type string
+
+// Supported field types, mirroring the fields_type_check constraint
+const (
+	TypeText         Type = "text"
+	TypeNumber       Type = "number"
+	TypeInteger      Type = "integer"
+	TypeBoolean      Type = "boolean"
+	TypeDate         Type = "date"
+	TypeSingleSelect Type = "single-select"
+	TypeMultiSelect  Type = "multi-select"
+	TypeMoney        Type = "money"
+)
+
+// DateLayout is the format date values and date options use
+const DateLayout = "2006-01-02"
+
+func (t Type) IsValid() bool {
+	switch t {
+	case TypeText, TypeNumber, TypeInteger, TypeBoolean, TypeDate,
+		TypeSingleSelect, TypeMultiSelect, TypeMoney:
+		return true
+	default:
+		return false
+	}
+}
+
+func (t Type) isSelect() bool {
+	return t == TypeSingleSelect || t == TypeMultiSelect
+}
+
+// Options store the per type constraints of a field.
+//
+// Min and Max bound the length of text values, the value of number,
+// integer and money (minor units) values and the number of choices of
+// multi-select values. MinDate and MaxDate bound date values, Regex
+// constrains text values and Values lists the choices of select fields.
+type Options struct {
+	Required bool     `json:"required,omitempty"`
+	Min      *float64 `json:"min,omitempty"`
+	Max      *float64 `json:"max,omitempty"`
+	MinDate  string   `json:"min_date,omitempty"`
+	MaxDate  string   `json:"max_date,omitempty"`
+	Regex    string   `json:"regex,omitempty"`
+	Values   []string `json:"values,omitempty"`
+}
+
+// UnmarshalJSON rejects unknown options so typos are not silently dropped.
+// It returns the same invalid options error as Model.Validate.
+func (o *Options) UnmarshalJSON(data []byte) error {
+	type options Options
+
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = Options{}
+		return nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+
+	opts := options{}
+	if err := dec.Decode(&opts); err != nil {
+		return apierrors.BadRequest("invalid options", "options has an unknown key or a value of the wrong type")
+	}
+
+	*o = Options(opts)
+	return nil
+}
+
+// Scan implements sql.Scanner for the options JSON column. Unlike request
+// bodies, stored options are read leniently, so rows written before options
+// were typed keep loading and their unknown keys are ignored.
+func (o *Options) Scan(src any) error {
+	type options Options
+
+	var data []byte
+	switch v := src.(type) {
+	case nil:
+		*o = Options{}
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("field.Options.Scan: unsupported type %T", src)
+	}
+
+	opts := options{}
+	if err := json.Unmarshal(data, &opts); err != nil {
+		return fmt.Errorf("field.Options.Scan: %w", err)
+	}
+
+	*o = Options(opts)
+	return nil
+}
+
+// Value implements driver.Valuer for the options JSON column
+func (o Options) Value() (driver.Value, error) {
+	b, err := json.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(b), nil
+}
+
+func (o *Options) validate(t Type) error {
+	if o.Regex != "" {
+		if t != TypeText {
+			return errors.New("regex is only allowed for text fields")
+		}
+
+		if _, err := regexp.Compile(o.Regex); err != nil {
+			return errors.New("regex does not compile")
+		}
+	}
+
+	if len(o.Values) > 0 && !t.isSelect() {
+		return errors.New("values are only allowed for select fields")
+	}
+
+	if (o.MinDate != "" || o.MaxDate != "") && t != TypeDate {
+		return errors.New("min_date and max_date are only allowed for date fields")
+	}
+
+	if err := o.validateBounds(t); err != nil {
+		return err
+	}
+
+	switch t {
+	case TypeSingleSelect, TypeMultiSelect:
+		return o.validateValues()
+	case TypeDate:
+		return o.validateDates()
+	}
+
+	return nil
+}
+
+func (o *Options) validateBounds(t Type) error {
+	if o.Min == nil && o.Max == nil {
+		return nil
+	}
+
+	switch t {
+	case TypeBoolean, TypeDate, TypeSingleSelect:
+		return fmt.Errorf("min and max are not allowed for %s fields", t)
+	}
+
+	for _, bound := range []*float64{o.Min, o.Max} {
+		if bound == nil {
+			continue
+		}
+
+		if math.IsNaN(*bound) || math.IsInf(*bound, 0) {
+			return errors.New("min and max must be finite")
+		}
+
+		if t != TypeNumber && *bound != math.Trunc(*bound) {
+			return fmt.Errorf("min and max must be whole numbers for %s fields", t)
+		}
+
+		if (t == TypeText || t == TypeMultiSelect) && *bound < 0 {
+			return fmt.Errorf("min and max must not be negative for %s fields", t)
+		}
+	}
+
+	if o.Min != nil && o.Max != nil && *o.Min > *o.Max {
+		return errors.New("min must not be greater than max")
+	}
+
+	return nil
+}
+
+func (o *Options) validateValues() error {
+	if len(o.Values) == 0 {
+		return errors.New("values are required for select fields")
+	}
+
+	seen := make(map[string]struct{}, len(o.Values))
+	for _, v := range o.Values {
+		if v == "" {
+			return errors.New("values must not be empty")
+		}
+
+		if _, ok := seen[v]; ok {
+			return fmt.Errorf("duplicated value %q", v)
+		}
+		seen[v] = struct{}{}
+	}
+
+	return nil
+}
+
+func (o *Options) validateDates() error {
+	var minDate, maxDate time.Time
+	var err error
+
+	if o.MinDate != "" {
+		minDate, err = time.Parse(DateLayout, o.MinDate)
+		if err != nil {
+			return errors.New("min_date must be formatted as YYYY-MM-DD")
+		}
+	}
+
+	if o.MaxDate != "" {
+		maxDate, err = time.Parse(DateLayout, o.MaxDate)
+		if err != nil {
+			return errors.New("max_date must be formatted as YYYY-MM-DD")
+		}
+	}
+
+	if o.MinDate != "" && o.MaxDate != "" && minDate.After(maxDate) {
+		return errors.New("min_date must not be after max_date")
+	}
+
+	return nil
+}
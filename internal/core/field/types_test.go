@@ -0,0 +1,110 @@
+package field_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go-api/internal/core/field"
+	"go-api/pkg/apierrors"
+)
+
+func TestModel_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		field   string
+		wantErr bool
+	}{
+		{"Success with text", `{"name":"title","type":"text","options":{"required":true,"min":1,"max":40,"regex":"^[a-z]+$"}}`, false},
+		{"Success with number", `{"name":"hours","type":"number","options":{"min":0.5,"max":12}}`, false},
+		{"Success with date", `{"name":"start","type":"date","options":{"min_date":"2023-01-01","max_date":"2023-12-31"}}`, false},
+		{"Success with multi-select", `{"name":"tools","type":"multi-select","options":{"values":["a","b"],"max":2}}`, false},
+		{"Success with no options", `{"name":"urgent","type":"boolean"}`, false},
+		{"Fail with unknown type", `{"name":"x","type":"color"}`, true},
+		{"Fail with no name", `{"name":" ","type":"text"}`, true},
+		{"Fail with min greater than max", `{"name":"x","type":"integer","options":{"min":5,"max":1}}`, true},
+		{"Fail with fractional integer bound", `{"name":"x","type":"integer","options":{"min":1.5}}`, true},
+		{"Fail with bounds on boolean", `{"name":"x","type":"boolean","options":{"max":1}}`, true},
+		{"Fail with invalid regex", `{"name":"x","type":"text","options":{"regex":"("}}`, true},
+		{"Fail with regex on number", `{"name":"x","type":"number","options":{"regex":".*"}}`, true},
+		{"Fail with select without values", `{"name":"x","type":"single-select"}`, true},
+		{"Fail with duplicated values", `{"name":"x","type":"single-select","options":{"values":["a","a"]}}`, true},
+		{"Fail with values on text", `{"name":"x","type":"text","options":{"values":["a"]}}`, true},
+		{"Fail with invalid date", `{"name":"x","type":"date","options":{"min_date":"01/01/2023"}}`, true},
+		{"Fail with reversed dates", `{"name":"x","type":"date","options":{"min_date":"2023-02-01","max_date":"2023-01-01"}}`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fld := &field.Model{}
+			err := json.Unmarshal([]byte(tt.field), fld)
+			assert.NoError(t, err)
+
+			err = fld.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, http.StatusBadRequest, apierrors.Parse(err).StatusCode())
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestOptions_UnmarshalJSON(t *testing.T) {
+	t.Run("Fail with unknown option", func(t *testing.T) {
+		opts := &field.Options{}
+		err := json.Unmarshal([]byte(`{"minimum":1}`), opts)
+		assert.Equal(t, http.StatusBadRequest, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with option of the wrong type", func(t *testing.T) {
+		opts := &field.Options{}
+		err := json.Unmarshal([]byte(`{"min":"one"}`), opts)
+		assert.Equal(t, http.StatusBadRequest, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Success with null", func(t *testing.T) {
+		opts := &field.Options{Required: true}
+		err := json.Unmarshal([]byte(`null`), opts)
+		assert.NoError(t, err)
+		assert.False(t, opts.Required)
+	})
+}
+
+func TestOptions_Scan(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		want := field.Options{Required: true, Values: []string{"a", "b"}}
+
+		value, err := want.Value()
+		assert.NoError(t, err)
+
+		got := field.Options{}
+		err = got.Scan([]byte(value.(string)))
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("Success with null", func(t *testing.T) {
+		got := field.Options{Required: true}
+		err := got.Scan(nil)
+		assert.NoError(t, err)
+		assert.Equal(t, field.Options{}, got)
+	})
+
+	t.Run("Success ignoring legacy keys", func(t *testing.T) {
+		got := field.Options{}
+		err := got.Scan(`{"required":true,"placeholder":"e.g. 4"}`)
+		assert.NoError(t, err)
+		assert.Equal(t, field.Options{Required: true}, got)
+	})
+
+	t.Run("Fail with malformed options", func(t *testing.T) {
+		got := field.Options{}
+		err := got.Scan(`{"min":"one"}`)
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusInternalServerError, apierrors.Parse(err).StatusCode())
+	})
+}
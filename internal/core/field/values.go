@@ -0,0 +1,296 @@
+package field
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"go-api/pkg/apierrors"
+)
+
+// MaxValueLength is the size of the advertisement_field value column
+const MaxValueLength = 250
+
+// ValueError describes why the value of a field was rejected
+type ValueError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValidateValues checks values keyed by field name against the field
+// definitions of a category. It returns the values in their storage form
+// keyed by field ID, or a bad request listing every invalid field.
+func ValidateValues(fields []*Model, values map[string]any) (map[uuid.UUID]string, error) {
+	byName := make(map[string]*Model, len(fields))
+	for _, f := range fields {
+		byName[f.Name] = f
+	}
+
+	stored := make(map[uuid.UUID]string, len(values))
+	invalid := make([]ValueError, 0)
+
+	for name, v := range values {
+		f, ok := byName[name]
+		if !ok {
+			invalid = append(invalid, ValueError{Field: name, Message: "field does not belong to the category"})
+			continue
+		}
+
+		if v == nil {
+			continue
+		}
+
+		s, err := f.Normalize(v)
+		if err != nil {
+			invalid = append(invalid, ValueError{Field: name, Message: err.Error()})
+			continue
+		}
+		stored[f.ID] = s
+	}
+
+	for _, f := range fields {
+		if _, ok := stored[f.ID]; !ok && f.Options.Required && !hasError(invalid, f.Name) {
+			invalid = append(invalid, ValueError{Field: f.Name, Message: "value is required"})
+		}
+	}
+
+	if len(invalid) > 0 {
+		sort.Slice(invalid, func(i, j int) bool { return invalid[i].Field < invalid[j].Field })
+		return nil, apierrors.BadRequest("invalid fields").WithDetails(invalid)
+	}
+
+	return stored, nil
+}
+
+func hasError(errs []ValueError, name string) bool {
+	for _, e := range errs {
+		if e.Field == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Normalize validates a JSON decoded value against the field type and
+// options and returns the string stored in advertisement_field
+func (m *Model) Normalize(v any) (string, error) {
+	var s string
+	var err error
+
+	switch m.Type {
+	case TypeText:
+		s, err = m.normalizeText(v)
+	case TypeNumber:
+		s, err = m.normalizeNumber(v, false)
+	case TypeInteger, TypeMoney:
+		s, err = m.normalizeNumber(v, true)
+	case TypeBoolean:
+		b, ok := v.(bool)
+		if !ok {
+			return "", errors.New("must be a boolean")
+		}
+		s = strconv.FormatBool(b)
+	case TypeDate:
+		s, err = m.normalizeDate(v)
+	case TypeSingleSelect:
+		s, err = m.normalizeSingleSelect(v)
+	case TypeMultiSelect:
+		s, err = m.normalizeMultiSelect(v)
+	default:
+		return "", fmt.Errorf("unsupported field type %q", m.Type)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if len(s) > MaxValueLength {
+		return "", errors.New("value is too long")
+	}
+
+	return s, nil
+}
+
+// Decode converts a stored value back to its typed form
+func (m *Model) Decode(s string) (any, error) {
+	switch m.Type {
+	case TypeNumber:
+		return strconv.ParseFloat(s, 64)
+	case TypeInteger, TypeMoney:
+		return strconv.ParseInt(s, 10, 64)
+	case TypeBoolean:
+		return strconv.ParseBool(s)
+	case TypeMultiSelect:
+		values := []string{}
+		err := json.Unmarshal([]byte(s), &values)
+		return values, err
+	default:
+		return s, nil
+	}
+}
+
+func (m *Model) normalizeText(v any) (string, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", errors.New("must be a string")
+	}
+
+	length := float64(utf8.RuneCountInString(s))
+	if m.Options.Min != nil && length < *m.Options.Min {
+		return "", fmt.Errorf("must have at least %v characters", *m.Options.Min)
+	}
+	if m.Options.Max != nil && length > *m.Options.Max {
+		return "", fmt.Errorf("must have at most %v characters", *m.Options.Max)
+	}
+
+	if m.Options.Regex != "" {
+		re, err := regexp.Compile(m.Options.Regex)
+		if err != nil || !re.MatchString(s) {
+			return "", errors.New("does not match the expected format")
+		}
+	}
+
+	return s, nil
+}
+
+func (m *Model) normalizeNumber(v any, whole bool) (string, error) {
+	var n float64
+
+	switch num := v.(type) {
+	case float64:
+		n = num
+	case json.Number:
+		f, err := num.Float64()
+		if err != nil {
+			return "", errors.New("must be a number")
+		}
+		n = f
+	default:
+		return "", errors.New("must be a number")
+	}
+
+	if math.IsNaN(n) || math.IsInf(n, 0) {
+		return "", errors.New("must be a number")
+	}
+
+	if whole && n != math.Trunc(n) {
+		return "", errors.New("must be a whole number")
+	}
+
+	// float64(math.MaxInt64) rounds up to 2^63, which int64 cannot hold
+	if whole && (n < math.MinInt64 || n >= math.MaxInt64) {
+		return "", errors.New("must be between -9223372036854775808 and 9223372036854775807")
+	}
+
+	if m.Options.Min != nil && n < *m.Options.Min {
+		return "", fmt.Errorf("must be greater than or equal to %v", *m.Options.Min)
+	}
+	if m.Options.Max != nil && n > *m.Options.Max {
+		return "", fmt.Errorf("must be less than or equal to %v", *m.Options.Max)
+	}
+
+	if whole {
+		return strconv.FormatInt(int64(n), 10), nil
+	}
+
+	return strconv.FormatFloat(n, 'f', -1, 64), nil
+}
+
+func (m *Model) normalizeDate(v any) (string, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", errors.New("must be a date formatted as YYYY-MM-DD")
+	}
+
+	date, err := time.Parse(DateLayout, s)
+	if err != nil {
+		return "", errors.New("must be a date formatted as YYYY-MM-DD")
+	}
+
+	if m.Options.MinDate != "" {
+		minDate, _ := time.Parse(DateLayout, m.Options.MinDate)
+		if date.Before(minDate) {
+			return "", fmt.Errorf("must not be before %s", m.Options.MinDate)
+		}
+	}
+	if m.Options.MaxDate != "" {
+		maxDate, _ := time.Parse(DateLayout, m.Options.MaxDate)
+		if date.After(maxDate) {
+			return "", fmt.Errorf("must not be after %s", m.Options.MaxDate)
+		}
+	}
+
+	return s, nil
+}
+
+func (m *Model) normalizeSingleSelect(v any) (string, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", errors.New("must be a string")
+	}
+
+	if !m.allows(s) {
+		return "", fmt.Errorf("%q is not an allowed value", s)
+	}
+
+	return s, nil
+}
+
+func (m *Model) normalizeMultiSelect(v any) (string, error) {
+	items, ok := v.([]any)
+	if !ok {
+		return "", errors.New("must be a list of strings")
+	}
+
+	values := make([]string, 0, len(items))
+	seen := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		s, ok := item.(string)
+		if !ok {
+			return "", errors.New("must be a list of strings")
+		}
+
+		if !m.allows(s) {
+			return "", fmt.Errorf("%q is not an allowed value", s)
+		}
+
+		if _, ok := seen[s]; ok {
+			return "", fmt.Errorf("%q is duplicated", s)
+		}
+		seen[s] = struct{}{}
+		values = append(values, s)
+	}
+
+	count := float64(len(values))
+	if m.Options.Min != nil && count < *m.Options.Min {
+		return "", fmt.Errorf("must have at least %v values", *m.Options.Min)
+	}
+	if m.Options.Max != nil && count > *m.Options.Max {
+		return "", fmt.Errorf("must have at most %v values", *m.Options.Max)
+	}
+
+	b, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func (m *Model) allows(value string) bool {
+	for _, v := range m.Options.Values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
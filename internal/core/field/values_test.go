@@ -0,0 +1,96 @@
+package field_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"go-api/internal/core/field"
+	"go-api/pkg/apierrors"
+)
+
+func TestModel_Normalize(t *testing.T) {
+	min, max := float64(1), float64(3)
+
+	tests := []struct {
+		name    string
+		field   *field.Model
+		value   any
+		want    string
+		wantErr bool
+	}{
+		{"Success with text", &field.Model{Type: field.TypeText, Options: field.Options{Regex: "^[a-z]+$"}}, "abc", "abc", false},
+		{"Fail with text not matching regex", &field.Model{Type: field.TypeText, Options: field.Options{Regex: "^[a-z]+$"}}, "ABC", "", true},
+		{"Fail with text too long", &field.Model{Type: field.TypeText, Options: field.Options{Max: &max}}, "abcd", "", true},
+		{"Success with number", &field.Model{Type: field.TypeNumber}, 2.5, "2.5", false},
+		{"Fail with number out of range", &field.Model{Type: field.TypeNumber, Options: field.Options{Min: &min}}, 0.5, "", true},
+		{"Success with integer", &field.Model{Type: field.TypeInteger}, float64(42), "42", false},
+		{"Fail with fractional integer", &field.Model{Type: field.TypeInteger}, 4.2, "", true},
+		{"Fail with integer out of range", &field.Model{Type: field.TypeInteger}, 1e19, "", true},
+		{"Fail with money out of range", &field.Model{Type: field.TypeMoney}, -1e300, "", true},
+		{"Fail with integer of 2^63", &field.Model{Type: field.TypeInteger}, float64(math.MaxInt64), "", true},
+		{"Success with money", &field.Model{Type: field.TypeMoney}, float64(1050), "1050", false},
+		{"Success with boolean", &field.Model{Type: field.TypeBoolean}, true, "true", false},
+		{"Fail with boolean as string", &field.Model{Type: field.TypeBoolean}, "true", "", true},
+		{"Success with date", &field.Model{Type: field.TypeDate}, "2023-05-01", "2023-05-01", false},
+		{"Fail with date before min", &field.Model{Type: field.TypeDate, Options: field.Options{MinDate: "2023-06-01"}}, "2023-05-01", "", true},
+		{"Success with single-select", &field.Model{Type: field.TypeSingleSelect, Options: field.Options{Values: []string{"a", "b"}}}, "b", "b", false},
+		{"Fail with unknown choice", &field.Model{Type: field.TypeSingleSelect, Options: field.Options{Values: []string{"a", "b"}}}, "c", "", true},
+		{"Success with multi-select", &field.Model{Type: field.TypeMultiSelect, Options: field.Options{Values: []string{"a", "b"}}}, []any{"b", "a"}, `["b","a"]`, false},
+		{"Fail with duplicated choice", &field.Model{Type: field.TypeMultiSelect, Options: field.Options{Values: []string{"a", "b"}}}, []any{"a", "a"}, "", true},
+		{"Fail with too many choices", &field.Model{Type: field.TypeMultiSelect, Options: field.Options{Values: []string{"a", "b"}, Max: &min}}, []any{"a", "b"}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.field.Normalize(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestModel_Decode(t *testing.T) {
+	t.Run("Success with typed values", func(t *testing.T) {
+		got, err := (&field.Model{Type: field.TypeInteger}).Decode("42")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(42), got)
+
+		got, err = (&field.Model{Type: field.TypeBoolean}).Decode("true")
+		assert.NoError(t, err)
+		assert.Equal(t, true, got)
+
+		got, err = (&field.Model{Type: field.TypeMultiSelect}).Decode(`["a","b"]`)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, got)
+	})
+}
+
+func TestValidateValues(t *testing.T) {
+	fields := []*field.Model{
+		{ID: uuid.New(), Name: "hours", Type: field.TypeInteger, Options: field.Options{Required: true}},
+		{ID: uuid.New(), Name: "urgent", Type: field.TypeBoolean},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		got, err := field.ValidateValues(fields, map[string]any{"hours": float64(3), "urgent": nil})
+		assert.NoError(t, err)
+		assert.Equal(t, map[uuid.UUID]string{fields[0].ID: "3"}, got)
+	})
+
+	t.Run("Fail listing every invalid field", func(t *testing.T) {
+		got, err := field.ValidateValues(fields, map[string]any{"urgent": "yes", "color": "red"})
+		assert.Nil(t, got)
+		assert.Equal(t, []field.ValueError{
+			{Field: "color", Message: "field does not belong to the category"},
+			{Field: "hours", Message: "value is required"},
+			{Field: "urgent", Message: "must be a boolean"},
+		}, apierrors.Parse(err).Details)
+	})
+}
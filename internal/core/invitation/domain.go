@@ -0,0 +1,33 @@
+package invitation
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"go-api/pkg/utils"
+)
+
+type Handlers interface {
+	Create() gin.HandlerFunc
+	Accept() gin.HandlerFunc
+	Decline() gin.HandlerFunc
+	GetInvitations() gin.HandlerFunc
+}
+
+type Repository interface {
+	Create(ctx context.Context, invitation *Model) (*Model, error)
+	GetByID(ctx context.Context, invitationID uuid.UUID) (*Model, error)
+	Accept(ctx context.Context, invitationID uuid.UUID) (*Model, error)
+	Decline(ctx context.Context, invitationID uuid.UUID) (*Model, error)
+	GetByCostumer(ctx context.Context, costumerID uuid.UUID, status Status, pq *utils.PaginationQuery) (*List, error)
+	GetByWorker(ctx context.Context, workerID uuid.UUID, status Status, pq *utils.PaginationQuery) (*List, error)
+}
+
+type UseCase interface {
+	Create(ctx context.Context, invitation *Model) (*Model, error)
+	Accept(ctx context.Context, invitationID uuid.UUID) (*Model, error)
+	Decline(ctx context.Context, invitationID uuid.UUID) (*Model, error)
+	GetInvitations(ctx context.Context, status Status, pq *utils.PaginationQuery) (*List, error)
+}
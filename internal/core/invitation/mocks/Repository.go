@@ -0,0 +1,190 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package invitationmock
+
+import (
+	context "context"
+	invitation "go-api/internal/core/invitation"
+
+	mock "github.com/stretchr/testify/mock"
+
+	utils "go-api/pkg/utils"
+
+	uuid "github.com/google/uuid"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+// Accept provides a mock function with given fields: ctx, invitationID
+func (_m *Repository) Accept(ctx context.Context, invitationID uuid.UUID) (*invitation.Model, error) {
+	ret := _m.Called(ctx, invitationID)
+
+	var r0 *invitation.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*invitation.Model, error)); ok {
+		return rf(ctx, invitationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *invitation.Model); ok {
+		r0 = rf(ctx, invitationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*invitation.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, invitationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: ctx, _a1
+func (_m *Repository) Create(ctx context.Context, _a1 *invitation.Model) (*invitation.Model, error) {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 *invitation.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *invitation.Model) (*invitation.Model, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *invitation.Model) *invitation.Model); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*invitation.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *invitation.Model) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Decline provides a mock function with given fields: ctx, invitationID
+func (_m *Repository) Decline(ctx context.Context, invitationID uuid.UUID) (*invitation.Model, error) {
+	ret := _m.Called(ctx, invitationID)
+
+	var r0 *invitation.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*invitation.Model, error)); ok {
+		return rf(ctx, invitationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *invitation.Model); ok {
+		r0 = rf(ctx, invitationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*invitation.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, invitationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByCostumer provides a mock function with given fields: ctx, costumerID, status, pq
+func (_m *Repository) GetByCostumer(ctx context.Context, costumerID uuid.UUID, status invitation.Status, pq *utils.PaginationQuery) (*invitation.List, error) {
+	ret := _m.Called(ctx, costumerID, status, pq)
+
+	var r0 *invitation.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, invitation.Status, *utils.PaginationQuery) (*invitation.List, error)); ok {
+		return rf(ctx, costumerID, status, pq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, invitation.Status, *utils.PaginationQuery) *invitation.List); ok {
+		r0 = rf(ctx, costumerID, status, pq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*invitation.List)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, invitation.Status, *utils.PaginationQuery) error); ok {
+		r1 = rf(ctx, costumerID, status, pq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByID provides a mock function with given fields: ctx, invitationID
+func (_m *Repository) GetByID(ctx context.Context, invitationID uuid.UUID) (*invitation.Model, error) {
+	ret := _m.Called(ctx, invitationID)
+
+	var r0 *invitation.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*invitation.Model, error)); ok {
+		return rf(ctx, invitationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *invitation.Model); ok {
+		r0 = rf(ctx, invitationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*invitation.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, invitationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByWorker provides a mock function with given fields: ctx, workerID, status, pq
+func (_m *Repository) GetByWorker(ctx context.Context, workerID uuid.UUID, status invitation.Status, pq *utils.PaginationQuery) (*invitation.List, error) {
+	ret := _m.Called(ctx, workerID, status, pq)
+
+	var r0 *invitation.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, invitation.Status, *utils.PaginationQuery) (*invitation.List, error)); ok {
+		return rf(ctx, workerID, status, pq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, invitation.Status, *utils.PaginationQuery) *invitation.List); ok {
+		r0 = rf(ctx, workerID, status, pq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*invitation.List)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, invitation.Status, *utils.PaginationQuery) error); ok {
+		r1 = rf(ctx, workerID, status, pq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRepository(t mockConstructorTestingTNewRepository) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
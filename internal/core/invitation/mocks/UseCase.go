@@ -0,0 +1,138 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package invitationmock
+
+import (
+	context "context"
+	invitation "go-api/internal/core/invitation"
+
+	mock "github.com/stretchr/testify/mock"
+
+	utils "go-api/pkg/utils"
+
+	uuid "github.com/google/uuid"
+)
+
+// UseCase is an autogenerated mock type for the UseCase type
+type UseCase struct {
+	mock.Mock
+}
+
+// Accept provides a mock function with given fields: ctx, invitationID
+func (_m *UseCase) Accept(ctx context.Context, invitationID uuid.UUID) (*invitation.Model, error) {
+	ret := _m.Called(ctx, invitationID)
+
+	var r0 *invitation.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*invitation.Model, error)); ok {
+		return rf(ctx, invitationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *invitation.Model); ok {
+		r0 = rf(ctx, invitationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*invitation.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, invitationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: ctx, _a1
+func (_m *UseCase) Create(ctx context.Context, _a1 *invitation.Model) (*invitation.Model, error) {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 *invitation.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *invitation.Model) (*invitation.Model, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *invitation.Model) *invitation.Model); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*invitation.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *invitation.Model) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Decline provides a mock function with given fields: ctx, invitationID
+func (_m *UseCase) Decline(ctx context.Context, invitationID uuid.UUID) (*invitation.Model, error) {
+	ret := _m.Called(ctx, invitationID)
+
+	var r0 *invitation.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*invitation.Model, error)); ok {
+		return rf(ctx, invitationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *invitation.Model); ok {
+		r0 = rf(ctx, invitationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*invitation.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, invitationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInvitations provides a mock function with given fields: ctx, status, pq
+func (_m *UseCase) GetInvitations(ctx context.Context, status invitation.Status, pq *utils.PaginationQuery) (*invitation.List, error) {
+	ret := _m.Called(ctx, status, pq)
+
+	var r0 *invitation.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, invitation.Status, *utils.PaginationQuery) (*invitation.List, error)); ok {
+		return rf(ctx, status, pq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, invitation.Status, *utils.PaginationQuery) *invitation.List); ok {
+		r0 = rf(ctx, status, pq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*invitation.List)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, invitation.Status, *utils.PaginationQuery) error); ok {
+		r1 = rf(ctx, status, pq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewUseCase interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewUseCase creates a new instance of UseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewUseCase(t mockConstructorTestingTNewUseCase) *UseCase {
+	mock := &UseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
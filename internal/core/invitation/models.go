@@ -0,0 +1,74 @@
+package invitation
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"go-api/pkg/apierrors"
+)
+
+const maxMessageLength = 1000
+
+// Status of an invitation, mirroring the INVITATIONSTATUS enum
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusAccepted Status = "accepted"
+	StatusDeclined Status = "declined"
+	StatusExpired  Status = "expired"
+)
+
+// IsValid reports whether the status is one of the INVITATIONSTATUS values
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusPending, StatusAccepted, StatusDeclined, StatusExpired:
+		return true
+	default:
+		return false
+	}
+}
+
+// Model model store an invitation from a costumer to a worker to apply to
+// one of their advertisements
+type Model struct {
+	ID              uuid.UUID  `json:"id" db:"id"`
+	AdvertisementID uuid.UUID  `json:"advertisement_id" db:"advertisement_id"`
+	CostumerID      uuid.UUID  `json:"costumer_id" db:"costumer_id"`
+	WorkerID        uuid.UUID  `json:"worker_id" db:"worker_id"`
+	Status          Status     `json:"status" db:"status"`
+	Message         string     `json:"message" db:"message"`
+	RespondedAt     *time.Time `json:"responded_at" db:"responded_at"`
+	CreatedAt       time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// List model store invitation pages
+type List struct {
+	TotalCount  int       `json:"total_count"`
+	TotalPages  int       `json:"total_pages"`
+	Page        int       `json:"page"`
+	Size        int       `json:"size"`
+	HasMore     bool      `json:"has_more"`
+	Invitations *[]*Model `json:"invitations"`
+}
+
+// Validate checks the invitation data sent by the costumer
+func (m *Model) Validate() error {
+	if m.AdvertisementID == uuid.Nil {
+		return apierrors.BadRequest("advertisement_id is required")
+	}
+
+	if m.WorkerID == uuid.Nil {
+		return apierrors.BadRequest("worker_id is required")
+	}
+
+	m.Message = strings.TrimSpace(m.Message)
+	if len(m.Message) > maxMessageLength {
+		return apierrors.BadRequest("message is too long")
+	}
+
+	return nil
+}
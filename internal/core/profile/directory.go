@@ -0,0 +1,99 @@
+package profile
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"go-api/pkg/apierrors"
+	"go-api/pkg/geo"
+	"go-api/pkg/money"
+)
+
+// Orders accepted by the worker directory
+const (
+	OrderByRating   = "rating"
+	OrderByDistance = "distance"
+)
+
+// MaxDirectoryRadiusKm bounds the location filter of the worker directory
+const MaxDirectoryRadiusKm = 500
+
+// DirectoryQuery model store the filters of a worker directory search.
+// Every filter is optional; rate bounds are compared in Currency.
+type DirectoryQuery struct {
+	CategoryID *uuid.UUID
+	Skill      string
+	Center     *geo.Point
+	RadiusKm   float64
+	MinRating  *float64
+	MinRate    *int64
+	MaxRate    *int64
+	Currency   string
+}
+
+// DirectoryEntry model store a worker found in the directory. DistanceKm
+// is only set when the search has a location and is rounded up to whole
+// kilometers, since the service area center is private.
+type DirectoryEntry struct {
+	Profile       *Public  `json:"profile" db:"profile"`
+	ReviewCount   int      `json:"review_count" db:"review_count"`
+	AverageRating float64  `json:"average_rating" db:"average_rating"`
+	DistanceKm    *float64 `json:"distance_km,omitempty" db:"distance_km"`
+}
+
+// DirectoryList model store worker directory pages
+type DirectoryList struct {
+	TotalCount int                `json:"total_count"`
+	TotalPages int                `json:"total_pages"`
+	Page       int                `json:"page"`
+	Size       int                `json:"size"`
+	HasMore    bool               `json:"has_more"`
+	Workers    *[]*DirectoryEntry `json:"workers"`
+}
+
+// Validate checks the directory filters against the requested order
+func (q *DirectoryQuery) Validate(orderBy string) error {
+	switch orderBy {
+	case "", OrderByRating, OrderByDistance:
+	default:
+		return apierrors.BadRequest("invalid order_by")
+	}
+
+	q.Skill = strings.TrimSpace(q.Skill)
+	if len(q.Skill) > maxSkillLength {
+		return apierrors.BadRequest("skill is too long")
+	}
+
+	if q.Center != nil {
+		if err := q.Center.Validate(); err != nil {
+			return apierrors.BadRequest(err.Error())
+		}
+
+		if !(q.RadiusKm > 0 && q.RadiusKm <= MaxDirectoryRadiusKm) {
+			return apierrors.BadRequest(fmt.Sprintf("radius_km must be greater than 0 and at most %d", MaxDirectoryRadiusKm))
+		}
+	} else if orderBy == OrderByDistance {
+		return apierrors.BadRequest("ordering by distance requires lat and lng")
+	}
+
+	if q.MinRating != nil && !(*q.MinRating >= 1 && *q.MinRating <= 5) {
+		return apierrors.BadRequest("min_rating must be between 1 and 5")
+	}
+
+	if q.MinRate != nil && q.MaxRate != nil && *q.MinRate > *q.MaxRate {
+		return apierrors.BadRequest("min_rate must not be greater than max_rate")
+	}
+
+	q.Currency = strings.ToUpper(q.Currency)
+	if (q.MinRate != nil || q.MaxRate != nil) && q.Currency == "" {
+		return apierrors.BadRequest("currency is required to filter by rate")
+	}
+
+	if q.Currency != "" && !money.IsValidCurrency(q.Currency) {
+		return apierrors.BadRequest("invalid currency")
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package profile
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"go-api/pkg/utils"
+)
+
+type Handlers interface {
+	GetMe() gin.HandlerFunc
+	Update() gin.HandlerFunc
+	GetByWorker() gin.HandlerFunc
+	Directory() gin.HandlerFunc
+}
+
+type Repository interface {
+	Upsert(ctx context.Context, profile *Model) (*Model, error)
+	GetByUserID(ctx context.Context, userID uuid.UUID) (*Model, error)
+	GetPublic(ctx context.Context, userID uuid.UUID) (*Public, error)
+	Directory(ctx context.Context, query *DirectoryQuery, pq *utils.PaginationQuery) (*DirectoryList, error)
+}
+
+type UseCase interface {
+	GetMe(ctx context.Context) (*Model, error)
+	Update(ctx context.Context, profile *Model) (*Model, error)
+	GetByWorker(ctx context.Context, workerID uuid.UUID) (*Public, error)
+	Directory(ctx context.Context, query *DirectoryQuery, pq *utils.PaginationQuery) (*DirectoryList, error)
+}
@@ -0,0 +1,138 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package profilemock
+
+import (
+	context "context"
+	profile "go-api/internal/core/profile"
+
+	mock "github.com/stretchr/testify/mock"
+
+	utils "go-api/pkg/utils"
+
+	uuid "github.com/google/uuid"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+// Directory provides a mock function with given fields: ctx, query, pq
+func (_m *Repository) Directory(ctx context.Context, query *profile.DirectoryQuery, pq *utils.PaginationQuery) (*profile.DirectoryList, error) {
+	ret := _m.Called(ctx, query, pq)
+
+	var r0 *profile.DirectoryList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *profile.DirectoryQuery, *utils.PaginationQuery) (*profile.DirectoryList, error)); ok {
+		return rf(ctx, query, pq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *profile.DirectoryQuery, *utils.PaginationQuery) *profile.DirectoryList); ok {
+		r0 = rf(ctx, query, pq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*profile.DirectoryList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *profile.DirectoryQuery, *utils.PaginationQuery) error); ok {
+		r1 = rf(ctx, query, pq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByUserID provides a mock function with given fields: ctx, userID
+func (_m *Repository) GetByUserID(ctx context.Context, userID uuid.UUID) (*profile.Model, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 *profile.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*profile.Model, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *profile.Model); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*profile.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPublic provides a mock function with given fields: ctx, userID
+func (_m *Repository) GetPublic(ctx context.Context, userID uuid.UUID) (*profile.Public, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 *profile.Public
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*profile.Public, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *profile.Public); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*profile.Public)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upsert provides a mock function with given fields: ctx, _a1
+func (_m *Repository) Upsert(ctx context.Context, _a1 *profile.Model) (*profile.Model, error) {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 *profile.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *profile.Model) (*profile.Model, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *profile.Model) *profile.Model); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*profile.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *profile.Model) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRepository(t mockConstructorTestingTNewRepository) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,138 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package profilemock
+
+import (
+	context "context"
+	profile "go-api/internal/core/profile"
+
+	mock "github.com/stretchr/testify/mock"
+
+	utils "go-api/pkg/utils"
+
+	uuid "github.com/google/uuid"
+)
+
+// UseCase is an autogenerated mock type for the UseCase type
+type UseCase struct {
+	mock.Mock
+}
+
+// Directory provides a mock function with given fields: ctx, query, pq
+func (_m *UseCase) Directory(ctx context.Context, query *profile.DirectoryQuery, pq *utils.PaginationQuery) (*profile.DirectoryList, error) {
+	ret := _m.Called(ctx, query, pq)
+
+	var r0 *profile.DirectoryList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *profile.DirectoryQuery, *utils.PaginationQuery) (*profile.DirectoryList, error)); ok {
+		return rf(ctx, query, pq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *profile.DirectoryQuery, *utils.PaginationQuery) *profile.DirectoryList); ok {
+		r0 = rf(ctx, query, pq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*profile.DirectoryList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *profile.DirectoryQuery, *utils.PaginationQuery) error); ok {
+		r1 = rf(ctx, query, pq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByWorker provides a mock function with given fields: ctx, workerID
+func (_m *UseCase) GetByWorker(ctx context.Context, workerID uuid.UUID) (*profile.Public, error) {
+	ret := _m.Called(ctx, workerID)
+
+	var r0 *profile.Public
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*profile.Public, error)); ok {
+		return rf(ctx, workerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *profile.Public); ok {
+		r0 = rf(ctx, workerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*profile.Public)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, workerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMe provides a mock function with given fields: ctx
+func (_m *UseCase) GetMe(ctx context.Context) (*profile.Model, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *profile.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*profile.Model, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *profile.Model); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*profile.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, _a1
+func (_m *UseCase) Update(ctx context.Context, _a1 *profile.Model) (*profile.Model, error) {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 *profile.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *profile.Model) (*profile.Model, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *profile.Model) *profile.Model); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*profile.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *profile.Model) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewUseCase interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewUseCase creates a new instance of UseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewUseCase(t mockConstructorTestingTNewUseCase) *UseCase {
+	mock := &UseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
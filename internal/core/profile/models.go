@@ -0,0 +1,243 @@
+package profile
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"go-api/pkg/apierrors"
+	"go-api/pkg/geo"
+	"go-api/pkg/money"
+)
+
+const (
+	maxDisplayNameLength = 80
+	maxBioLength         = 2000
+	maxSkills            = 30
+	maxSkillLength       = 50
+	maxCategories        = 20
+	maxAreaLength        = 120
+	// MaxServiceRadiusKm bounds how far from its center a service area reaches
+	MaxServiceRadiusKm = 200
+)
+
+var countryRegex = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// Model model store the profile a worker shows to costumers
+type Model struct {
+	UserID      uuid.UUID    `json:"user_id" db:"user_id"`
+	DisplayName string       `json:"display_name" db:"display_name"`
+	Bio         string       `json:"bio" db:"bio"`
+	Skills      Skills       `json:"skills" db:"skills"`
+	Categories  []uuid.UUID  `json:"categories" db:"-"`
+	HourlyRate  *int64       `json:"hourly_rate" db:"hourly_rate"`
+	Currency    string       `json:"currency,omitempty" db:"currency"`
+	ServiceArea *ServiceArea `json:"service_area,omitempty" db:"service_area"`
+	CreatedAt   time.Time    `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time    `json:"updated_at" db:"updated_at"`
+}
+
+// ServiceArea model store the region a worker serves, a radius around a point
+type ServiceArea struct {
+	City     string  `json:"city"`
+	State    string  `json:"state,omitempty"`
+	Country  string  `json:"country"`
+	RadiusKm float64 `json:"radius_km"`
+	geo.Point
+}
+
+// Public model store the profile fields anyone can see. It is read from the
+// worker_profile_public JSON so it can be embedded in other listings.
+type Public struct {
+	UserID      uuid.UUID   `json:"user_id"`
+	DisplayName string      `json:"display_name"`
+	Bio         string      `json:"bio"`
+	Skills      []string    `json:"skills"`
+	Categories  []uuid.UUID `json:"categories"`
+	HourlyRate  *int64      `json:"hourly_rate"`
+	Currency    string      `json:"currency,omitempty"`
+	ServiceArea *PublicArea `json:"service_area,omitempty"`
+}
+
+// PublicArea model store a service area without its center
+type PublicArea struct {
+	City     string  `json:"city"`
+	State    string  `json:"state,omitempty"`
+	Country  string  `json:"country"`
+	RadiusKm float64 `json:"radius_km"`
+}
+
+// Skills is the list of skills a worker offers, stored as a JSON array
+type Skills []string
+
+// Validate checks the profile sent by the worker and normalizes its skills,
+// categories and currency
+func (m *Model) Validate() error {
+	m.DisplayName = strings.TrimSpace(m.DisplayName)
+	if m.DisplayName == "" {
+		return apierrors.BadRequest("display_name is required")
+	}
+
+	if len(m.DisplayName) > maxDisplayNameLength {
+		return apierrors.BadRequest("display_name is too long")
+	}
+
+	m.Bio = strings.TrimSpace(m.Bio)
+	if len(m.Bio) > maxBioLength {
+		return apierrors.BadRequest("bio is too long")
+	}
+
+	skills, err := normalizeSkills(m.Skills)
+	if err != nil {
+		return err
+	}
+	m.Skills = skills
+
+	m.Categories = uniqueIDs(m.Categories)
+	if len(m.Categories) > maxCategories {
+		return apierrors.BadRequest(fmt.Sprintf("a profile may serve at most %d categories", maxCategories))
+	}
+
+	if m.HourlyRate == nil {
+		m.Currency = ""
+	} else {
+		if *m.HourlyRate < 0 {
+			return apierrors.BadRequest("hourly_rate must not be negative")
+		}
+
+		m.Currency = strings.ToUpper(m.Currency)
+		if !money.IsValidCurrency(m.Currency) {
+			return apierrors.BadRequest("invalid currency")
+		}
+	}
+
+	if m.ServiceArea != nil {
+		return m.ServiceArea.Validate()
+	}
+
+	return nil
+}
+
+// Validate checks the address and the reach of the service area
+func (a *ServiceArea) Validate() error {
+	a.City = strings.TrimSpace(a.City)
+	a.State = strings.TrimSpace(a.State)
+	if a.City == "" {
+		return apierrors.BadRequest("service_area city is required")
+	}
+
+	if len(a.City) > maxAreaLength || len(a.State) > maxAreaLength {
+		return apierrors.BadRequest("service_area fields are too long")
+	}
+
+	a.Country = strings.ToUpper(strings.TrimSpace(a.Country))
+	if !countryRegex.MatchString(a.Country) {
+		return apierrors.BadRequest("service_area country must be a 2 letter ISO 3166 code")
+	}
+
+	if err := a.Point.Validate(); err != nil {
+		return apierrors.BadRequest(err.Error())
+	}
+
+	if !(a.RadiusKm > 0 && a.RadiusKm <= MaxServiceRadiusKm) {
+		return apierrors.BadRequest(fmt.Sprintf("service_area radius_km must be greater than 0 and at most %d", MaxServiceRadiusKm))
+	}
+
+	return nil
+}
+
+// normalizeSkills trims the skills and drops empty and repeated ones,
+// comparing them without case
+func normalizeSkills(skills Skills) (Skills, error) {
+	seen := make(map[string]bool, len(skills))
+	normalized := make(Skills, 0, len(skills))
+	for _, s := range skills {
+		s = strings.TrimSpace(s)
+		key := strings.ToLower(s)
+		if s == "" || seen[key] {
+			continue
+		}
+
+		if len(s) > maxSkillLength {
+			return nil, apierrors.BadRequest(fmt.Sprintf("skill %q is too long", s))
+		}
+
+		seen[key] = true
+		normalized = append(normalized, s)
+	}
+
+	if len(normalized) > maxSkills {
+		return nil, apierrors.BadRequest(fmt.Sprintf("a profile may list at most %d skills", maxSkills))
+	}
+
+	return normalized, nil
+}
+
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if id == uuid.Nil || seen[id] {
+			continue
+		}
+
+		seen[id] = true
+		unique = append(unique, id)
+	}
+
+	return unique
+}
+
+// Scan implements sql.Scanner for the skills JSON column
+func (s *Skills) Scan(src any) error {
+	return scanJSON(src, s, "profile.Skills.Scan")
+}
+
+// Value implements driver.Valuer for the skills JSON column
+func (s Skills) Value() (driver.Value, error) {
+	if s == nil {
+		s = Skills{}
+	}
+
+	return valueJSON(s)
+}
+
+// Scan implements sql.Scanner for the service area JSON column
+func (a *ServiceArea) Scan(src any) error {
+	return scanJSON(src, a, "profile.ServiceArea.Scan")
+}
+
+// Value implements driver.Valuer for the service area JSON column
+func (a ServiceArea) Value() (driver.Value, error) {
+	return valueJSON(a)
+}
+
+// Scan implements sql.Scanner for the worker_profile_public JSON
+func (p *Public) Scan(src any) error {
+	return scanJSON(src, p, "profile.Public.Scan")
+}
+
+func scanJSON(src, dest any, op string) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, dest)
+	case string:
+		return json.Unmarshal([]byte(v), dest)
+	default:
+		return fmt.Errorf("%s: unsupported type %T", op, src)
+	}
+}
+
+func valueJSON(v any) (driver.Value, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,16 @@
+package refresh
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository refresh token interface
+type Repository interface {
+	Create(ctx context.Context, token *Token) error
+	Get(ctx context.Context, hash string) (*Token, error)
+	Rotate(ctx context.Context, token, next *Token) (bool, error)
+	RevokeFamily(ctx context.Context, familyID uuid.UUID) error
+	RevokeUser(ctx context.Context, userID uuid.UUID) error
+}
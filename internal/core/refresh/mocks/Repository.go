@@ -0,0 +1,124 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package refreshmock
+
+import (
+	context "context"
+	refresh "go-api/internal/core/refresh"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, token
+func (_m *Repository) Create(ctx context.Context, token *refresh.Token) error {
+	ret := _m.Called(ctx, token)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *refresh.Token) error); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, hash
+func (_m *Repository) Get(ctx context.Context, hash string) (*refresh.Token, error) {
+	ret := _m.Called(ctx, hash)
+
+	var r0 *refresh.Token
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*refresh.Token, error)); ok {
+		return rf(ctx, hash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *refresh.Token); ok {
+		r0 = rf(ctx, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*refresh.Token)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeFamily provides a mock function with given fields: ctx, familyID
+func (_m *Repository) RevokeFamily(ctx context.Context, familyID uuid.UUID) error {
+	ret := _m.Called(ctx, familyID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, familyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokeUser provides a mock function with given fields: ctx, userID
+func (_m *Repository) RevokeUser(ctx context.Context, userID uuid.UUID) error {
+	ret := _m.Called(ctx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Rotate provides a mock function with given fields: ctx, token, next
+func (_m *Repository) Rotate(ctx context.Context, token *refresh.Token, next *refresh.Token) (bool, error) {
+	ret := _m.Called(ctx, token, next)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *refresh.Token, *refresh.Token) (bool, error)); ok {
+		return rf(ctx, token, next)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *refresh.Token, *refresh.Token) bool); ok {
+		r0 = rf(ctx, token, next)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *refresh.Token, *refresh.Token) error); ok {
+		r1 = rf(ctx, token, next)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRepository(t mockConstructorTestingTNewRepository) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,57 @@
+package refresh
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const tokenBytes = 32
+
+// Token is a refresh token as stored server side, under the hash of the
+// value handed to the client. Rotating a token issues the next one in the
+// same family, so replaying a rotated token revokes every token descending
+// from the same login.
+type Token struct {
+	Hash      string    `json:"-"`
+	FamilyID  uuid.UUID `json:"family_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// New generates a refresh token value for the user and the token to store
+// for it. A nil family starts a new one.
+func New(userID, familyID uuid.UUID, duration time.Duration) (string, *Token, error) {
+	b := make([]byte, tokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", nil, err
+	}
+
+	if familyID == uuid.Nil {
+		familyID = uuid.New()
+	}
+
+	value := base64.RawURLEncoding.EncodeToString(b)
+	return value, &Token{
+		Hash:      Hash(value),
+		FamilyID:  familyID,
+		UserID:    userID,
+		ExpiresAt: time.Now().Add(duration),
+	}, nil
+}
+
+// Hash returns the form a refresh token value is stored under, so a leak of
+// the store does not leak usable tokens
+func Hash(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+// TTL returns how long the token is still valid
+func (t *Token) TTL() time.Duration {
+	return time.Until(t.ExpiresAt)
+}
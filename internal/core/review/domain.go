@@ -0,0 +1,28 @@
+package review
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"go-api/pkg/utils"
+)
+
+type Handlers interface {
+	Create() gin.HandlerFunc
+	GetByAdvertisement() gin.HandlerFunc
+	GetByUser() gin.HandlerFunc
+}
+
+type Repository interface {
+	Create(ctx context.Context, review *Model) (*Model, error)
+	GetByAdvertisement(ctx context.Context, adID uuid.UUID, pq *utils.PaginationQuery) (*List, error)
+	GetByUser(ctx context.Context, userID uuid.UUID, direction Direction, pq *utils.PaginationQuery) (*List, error)
+}
+
+type UseCase interface {
+	Create(ctx context.Context, review *Model) (*Model, error)
+	GetByAdvertisement(ctx context.Context, adID uuid.UUID, pq *utils.PaginationQuery) (*List, error)
+	GetByUser(ctx context.Context, userID uuid.UUID, direction Direction, pq *utils.PaginationQuery) (*List, error)
+}
@@ -0,0 +1,112 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package reviewmock
+
+import (
+	context "context"
+	review "go-api/internal/core/review"
+
+	mock "github.com/stretchr/testify/mock"
+
+	utils "go-api/pkg/utils"
+
+	uuid "github.com/google/uuid"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, _a1
+func (_m *Repository) Create(ctx context.Context, _a1 *review.Model) (*review.Model, error) {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 *review.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *review.Model) (*review.Model, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *review.Model) *review.Model); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*review.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *review.Model) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByAdvertisement provides a mock function with given fields: ctx, adID, pq
+func (_m *Repository) GetByAdvertisement(ctx context.Context, adID uuid.UUID, pq *utils.PaginationQuery) (*review.List, error) {
+	ret := _m.Called(ctx, adID, pq)
+
+	var r0 *review.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *utils.PaginationQuery) (*review.List, error)); ok {
+		return rf(ctx, adID, pq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *utils.PaginationQuery) *review.List); ok {
+		r0 = rf(ctx, adID, pq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*review.List)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *utils.PaginationQuery) error); ok {
+		r1 = rf(ctx, adID, pq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByUser provides a mock function with given fields: ctx, userID, direction, pq
+func (_m *Repository) GetByUser(ctx context.Context, userID uuid.UUID, direction review.Direction, pq *utils.PaginationQuery) (*review.List, error) {
+	ret := _m.Called(ctx, userID, direction, pq)
+
+	var r0 *review.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, review.Direction, *utils.PaginationQuery) (*review.List, error)); ok {
+		return rf(ctx, userID, direction, pq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, review.Direction, *utils.PaginationQuery) *review.List); ok {
+		r0 = rf(ctx, userID, direction, pq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*review.List)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, review.Direction, *utils.PaginationQuery) error); ok {
+		r1 = rf(ctx, userID, direction, pq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRepository(t mockConstructorTestingTNewRepository) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
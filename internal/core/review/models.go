@@ -0,0 +1,67 @@
+package review
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"go-api/pkg/apierrors"
+)
+
+const (
+	MinRating        = 1
+	MaxRating        = 5
+	maxCommentLength = 2000
+)
+
+// Direction tells which party of the advertisement rated the other, mirroring
+// the REVIEWDIRECTION enum
+type Direction string
+
+const (
+	DirectionCostumerToWorker Direction = "costumer_to_worker"
+	DirectionWorkerToCostumer Direction = "worker_to_costumer"
+)
+
+// IsValid reports whether the direction is one of the REVIEWDIRECTION values
+func (d Direction) IsValid() bool {
+	return d == DirectionCostumerToWorker || d == DirectionWorkerToCostumer
+}
+
+// Model model store a review left by one of the parties of an advertisement
+type Model struct {
+	ID              uuid.UUID `json:"id" db:"id"`
+	AdvertisementID uuid.UUID `json:"advertisement_id" db:"advertisement_id"`
+	ReviewerID      uuid.UUID `json:"reviewer_id" db:"reviewer_id"`
+	RevieweeID      uuid.UUID `json:"reviewee_id" db:"reviewee_id"`
+	Direction       Direction `json:"direction" db:"direction"`
+	Rating          float64   `json:"rating" db:"rating"`
+	Comment         string    `json:"comment" db:"comment"`
+	CreatedAt       time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// List model store review pages
+type List struct {
+	TotalCount int       `json:"total_count"`
+	TotalPages int       `json:"total_pages"`
+	Page       int       `json:"page"`
+	Size       int       `json:"size"`
+	HasMore    bool      `json:"has_more"`
+	Reviews    *[]*Model `json:"reviews"`
+}
+
+// Validate checks the review data sent by the reviewer
+func (m *Model) Validate() error {
+	if m.Rating < MinRating || m.Rating > MaxRating {
+		return apierrors.BadRequest("rating must be between 1 and 5")
+	}
+
+	m.Comment = strings.TrimSpace(m.Comment)
+	if len(m.Comment) > maxCommentLength {
+		return apierrors.BadRequest("comment is too long")
+	}
+
+	return nil
+}
@@ -13,6 +13,7 @@ type Handlers interface {
 	Register() gin.HandlerFunc
 	Login() gin.HandlerFunc
 	Logout() gin.HandlerFunc
+	Refresh() gin.HandlerFunc
 	Update() gin.HandlerFunc
 	Delete() gin.HandlerFunc
 	GetUserByID() gin.HandlerFunc
@@ -27,13 +28,17 @@ type Repository interface {
 	GetByID(ctx context.Context, userID uuid.UUID) (*Model, error)
 	FindByEmail(ctx context.Context, email string) (*Model, error)
 	GetUsers(ctx context.Context, pq *utils.PaginationQuery) (*List, error)
+	GetReputation(ctx context.Context, userID uuid.UUID) (*Reputation, error)
 }
 
 type UseCase interface {
 	Register(ctx context.Context, user *Model) (*Token, error)
 	Login(ctx context.Context, email, password string) (*Token, error)
+	Refresh(ctx context.Context, refreshToken string) (*Token, error)
+	RevokeRefreshTokens(ctx context.Context, userID uuid.UUID) error
 	Update(ctx context.Context, user *Model) (*Model, error)
 	Delete(ctx context.Context, userID uuid.UUID) error
 	GetByID(ctx context.Context, userID uuid.UUID) (*Model, error)
+	GetProfile(ctx context.Context, userID uuid.UUID) (*Model, error)
 	GetUsers(ctx context.Context, pq *utils.PaginationQuery) (*List, error)
 }
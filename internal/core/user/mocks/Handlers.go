@@ -108,6 +108,22 @@ func (_m *Handlers) Logout() gin.HandlerFunc {
 	return r0
 }
 
+// Refresh provides a mock function with given fields:
+func (_m *Handlers) Refresh() gin.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 gin.HandlerFunc
+	if rf, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+
+	return r0
+}
+
 // Register provides a mock function with given fields:
 func (_m *Handlers) Register() gin.HandlerFunc {
 	ret := _m.Called()
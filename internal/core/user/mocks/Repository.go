@@ -84,6 +84,32 @@ func (_m *Repository) GetByID(ctx context.Context, userID uuid.UUID) (*user.Mode
 	return r0, r1
 }
 
+// GetReputation provides a mock function with given fields: ctx, userID
+func (_m *Repository) GetReputation(ctx context.Context, userID uuid.UUID) (*user.Reputation, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 *user.Reputation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*user.Reputation, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *user.Reputation); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*user.Reputation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetUsers provides a mock function with given fields: ctx, pq
 func (_m *Repository) GetUsers(ctx context.Context, pq *utils.PaginationQuery) (*user.List, error) {
 	ret := _m.Called(ctx, pq)
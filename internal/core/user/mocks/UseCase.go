@@ -58,6 +58,32 @@ func (_m *UseCase) GetByID(ctx context.Context, userID uuid.UUID) (*user.Model,
 	return r0, r1
 }
 
+// GetProfile provides a mock function with given fields: ctx, userID
+func (_m *UseCase) GetProfile(ctx context.Context, userID uuid.UUID) (*user.Model, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 *user.Model
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*user.Model, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *user.Model); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*user.Model)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetUsers provides a mock function with given fields: ctx, pq
 func (_m *UseCase) GetUsers(ctx context.Context, pq *utils.PaginationQuery) (*user.List, error) {
 	ret := _m.Called(ctx, pq)
@@ -110,6 +136,32 @@ func (_m *UseCase) Login(ctx context.Context, email string, password string) (*u
 	return r0, r1
 }
 
+// Refresh provides a mock function with given fields: ctx, refreshToken
+func (_m *UseCase) Refresh(ctx context.Context, refreshToken string) (*user.Token, error) {
+	ret := _m.Called(ctx, refreshToken)
+
+	var r0 *user.Token
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*user.Token, error)); ok {
+		return rf(ctx, refreshToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *user.Token); ok {
+		r0 = rf(ctx, refreshToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*user.Token)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, refreshToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Register provides a mock function with given fields: ctx, _a1
 func (_m *UseCase) Register(ctx context.Context, _a1 *user.Model) (*user.Token, error) {
 	ret := _m.Called(ctx, _a1)
@@ -136,6 +188,20 @@ func (_m *UseCase) Register(ctx context.Context, _a1 *user.Model) (*user.Token,
 	return r0, r1
 }
 
+// RevokeRefreshTokens provides a mock function with given fields: ctx, userID
+func (_m *UseCase) RevokeRefreshTokens(ctx context.Context, userID uuid.UUID) error {
+	ret := _m.Called(ctx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: ctx, _a1
 func (_m *UseCase) Update(ctx context.Context, _a1 *user.Model) (*user.Model, error) {
 	ret := _m.Called(ctx, _a1)
@@ -10,6 +10,13 @@ import (
 	"go-api/pkg/utils"
 )
 
+// User roles, mirroring the ROLE enum
+const (
+	RoleAdmin    = "admin"
+	RoleCostumer = "costumer"
+	RoleWorker   = "worker"
+)
+
 // Model model store user data
 type Model struct {
 	ID        uuid.UUID `json:"id" db:"id"`
@@ -19,6 +26,8 @@ type Model struct {
 	CreatedAt time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
 	LastLogin time.Time `json:"last_login" db:"last_login"`
+
+	Reputation *Reputation `json:"reputation,omitempty" db:"-"`
 }
 
 // List model store user pages
@@ -33,8 +42,9 @@ type List struct {
 
 // Token model store user token
 type Token struct {
-	User  *Model `json:"user"`
-	Token string `json:"token"`
+	User         *Model `json:"user"`
+	Token        string `json:"token"`
+	RefreshToken string `json:"refresh_token"`
 }
 
 // CtxKey is a key used for the User object in the context
@@ -59,6 +69,16 @@ func (u *Model) Sanitize() {
 	u.Password = ""
 }
 
+func (u *Model) HasRole(roles ...string) bool {
+	for _, role := range roles {
+		if u.Role == role {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Get user from context
 func GetUserFromCtx(ctx context.Context) (*Model, error) {
 	user, ok := ctx.Value(CtxKey{}).(*Model)
@@ -68,3 +88,17 @@ func GetUserFromCtx(ctx context.Context) (*Model, error) {
 
 	return user, nil
 }
+
+// Get user from context and check it has one of the given roles
+func RequireRole(ctx context.Context, roles ...string) (*Model, error) {
+	user, err := GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.HasRole(roles...) {
+		return nil, apierrors.Forbidden()
+	}
+
+	return user, nil
+}
@@ -0,0 +1,34 @@
+package user
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"go-api/pkg/apierrors"
+)
+
+// CanActOn reports whether the user may act on a resource owned by ownerID.
+// Owners always may. Anyone else needs every override permission, so with
+// no overrides only the owner passes.
+func (u *Model) CanActOn(ownerID uuid.UUID, override ...Permission) bool {
+	if u.ID == ownerID {
+		return true
+	}
+
+	return len(override) > 0 && u.Can(override...)
+}
+
+// Get user from context and check it may act on a resource owned by ownerID
+func RequireOwner(ctx context.Context, ownerID uuid.UUID, override ...Permission) (*Model, error) {
+	user, err := GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.CanActOn(ownerID, override...) {
+		return nil, apierrors.Forbidden()
+	}
+
+	return user, nil
+}
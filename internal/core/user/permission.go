@@ -0,0 +1,100 @@
+package user
+
+// Permission is an action a role may perform. Handlers require permissions
+// instead of roles, so what each role can do is decided in one place.
+type Permission string
+
+// Permission catalog
+const (
+	PermUserRead   Permission = "users:read"
+	PermUserList   Permission = "users:list"
+	PermUserUpdate Permission = "users:update"
+	PermUserDelete Permission = "users:delete"
+	// PermUserManage allows acting on other users and changing roles
+	PermUserManage Permission = "users:manage"
+
+	PermCategoryManage Permission = "categories:manage"
+
+	PermAdvertisementCreate Permission = "advertisements:create"
+	PermAdvertisementUpdate Permission = "advertisements:update"
+	PermAdvertisementStatus Permission = "advertisements:status"
+	// PermAdvertisementManage allows seeing advertisements of other users as
+	// their owner does
+	PermAdvertisementManage Permission = "advertisements:manage"
+
+	PermCandidateApply  Permission = "candidates:apply"
+	PermCandidateRead   Permission = "candidates:read"
+	PermCandidateSelect Permission = "candidates:select"
+	PermOfferNegotiate  Permission = "offers:negotiate"
+
+	PermReviewCreate Permission = "reviews:create"
+
+	PermProfileManage Permission = "profiles:manage"
+
+	PermInvitationCreate  Permission = "invitations:create"
+	PermInvitationRead    Permission = "invitations:read"
+	PermInvitationRespond Permission = "invitations:respond"
+)
+
+// rolePermissions maps every role of the ROLE enum to what it may do. The
+// use cases still check ownership, such as a costumer only updating their
+// own advertisements.
+var rolePermissions = map[string]map[Permission]bool{
+	RoleAdmin: permissionSet(
+		PermUserRead,
+		PermUserList,
+		PermUserUpdate,
+		PermUserDelete,
+		PermUserManage,
+		PermCategoryManage,
+		PermAdvertisementStatus,
+		PermAdvertisementManage,
+	),
+	RoleCostumer: permissionSet(
+		PermUserRead,
+		PermUserUpdate,
+		PermUserDelete,
+		PermAdvertisementCreate,
+		PermAdvertisementUpdate,
+		PermAdvertisementStatus,
+		PermCandidateRead,
+		PermCandidateSelect,
+		PermOfferNegotiate,
+		PermReviewCreate,
+		PermInvitationCreate,
+		PermInvitationRead,
+	),
+	RoleWorker: permissionSet(
+		PermUserRead,
+		PermUserUpdate,
+		PermUserDelete,
+		PermAdvertisementStatus,
+		PermCandidateApply,
+		PermOfferNegotiate,
+		PermReviewCreate,
+		PermProfileManage,
+		PermInvitationRead,
+		PermInvitationRespond,
+	),
+}
+
+// Can reports whether the user role grants every given permission
+func (u *Model) Can(perms ...Permission) bool {
+	granted := rolePermissions[u.Role]
+	for _, perm := range perms {
+		if !granted[perm] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func permissionSet(perms ...Permission) map[Permission]bool {
+	set := make(map[Permission]bool, len(perms))
+	for _, perm := range perms {
+		set[perm] = true
+	}
+
+	return set
+}
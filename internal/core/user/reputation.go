@@ -0,0 +1,38 @@
+package user
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Reputation model store the summary of the reviews a user received
+type Reputation struct {
+	UserID        uuid.UUID `json:"-" db:"user_id"`
+	ReviewCount   int       `json:"review_count" db:"review_count"`
+	AverageRating float64   `json:"average_rating" db:"average_rating"`
+	Score         float64   `json:"score" db:"score"`
+	Histogram     Histogram `json:"histogram" db:"histogram"`
+}
+
+// Histogram counts the received reviews per star rating, from 1 to 5
+type Histogram map[int]int
+
+// Scan implements the sql.Scanner interface for the JSON histogram column
+func (h *Histogram) Scan(src any) error {
+	var data []byte
+	switch v := src.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	case nil:
+		*h = Histogram{}
+		return nil
+	default:
+		return fmt.Errorf("unsupported histogram type %T", src)
+	}
+
+	return json.Unmarshal(data, h)
+}
@@ -0,0 +1,329 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"go-api/internal/core/advertisement"
+	"go-api/internal/core/field"
+	"go-api/pkg/apierrors"
+	"go-api/pkg/config"
+	"go-api/pkg/utils"
+)
+
+type advertisementHandler struct {
+	cfg             *config.Config
+	advertisementUC advertisement.UseCase
+}
+
+func NewAdvertisementHandler(cfg *config.Config, advertisementUC advertisement.UseCase) advertisement.Handlers {
+	return &advertisementHandler{
+		cfg:             cfg,
+		advertisementUC: advertisementUC,
+	}
+}
+
+func (h *advertisementHandler) Create() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ad := &advertisement.Model{}
+		err := c.Bind(ad)
+		if err != nil {
+			c.JSON(apierrors.BadRequest().JSON())
+			return
+		}
+
+		createdAd, err := h.advertisementUC.Create(c, ad)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusCreated, createdAd)
+	}
+}
+
+func (h *advertisementHandler) Update() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := uuid.Parse(c.Param("advertisement_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		ad := &advertisement.Model{}
+		err = c.Bind(ad)
+		if err != nil {
+			c.JSON(apierrors.BadRequest().JSON())
+			return
+		}
+		ad.ID = id
+
+		updatedAd, err := h.advertisementUC.Update(c, ad)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusOK, updatedAd)
+	}
+}
+
+func (h *advertisementHandler) Cancel() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := uuid.Parse(c.Param("advertisement_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		canceledAd, err := h.advertisementUC.Cancel(c, id)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusOK, canceledAd)
+	}
+}
+
+func (h *advertisementHandler) ChangeStatus() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := uuid.Parse(c.Param("advertisement_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		change := &advertisement.StatusChange{}
+		err = c.Bind(change)
+		if err != nil {
+			c.JSON(apierrors.BadRequest().JSON())
+			return
+		}
+
+		updatedAd, err := h.advertisementUC.ChangeStatus(c, id, change.Status)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusOK, updatedAd)
+	}
+}
+
+func (h *advertisementHandler) GetByID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := uuid.Parse(c.Param("advertisement_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		ad, err := h.advertisementUC.GetByID(c, id)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusOK, ad)
+	}
+}
+
+func (h *advertisementHandler) GetByShareToken() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ad, err := h.advertisementUC.GetByShareToken(c, c.Param("share_token"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusOK, ad)
+	}
+}
+
+func (h *advertisementHandler) GetAdvertisements() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pagination, err := utils.GetPaginationFromCtx(c)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		query, err := parseListQuery(c)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		ads, err := h.advertisementUC.GetAdvertisements(c, query, pagination)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusOK, ads)
+	}
+}
+
+func (h *advertisementHandler) Search() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pagination, err := utils.GetPaginationFromCtx(c)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		query, err := parseSearchQuery(c)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		results, err := h.advertisementUC.Search(c, query, pagination)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusOK, results)
+	}
+}
+
+func (h *advertisementHandler) Nearby() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pagination, err := utils.GetPaginationFromCtx(c)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		query, err := parseNearbyQuery(c)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		results, err := h.advertisementUC.Nearby(c, query, pagination)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusOK, results)
+	}
+}
+
+// parseListQuery reads the category and the field filters, each one given
+// as f=name:operator:value
+func parseListQuery(c *gin.Context) (*advertisement.ListQuery, error) {
+	query := &advertisement.ListQuery{}
+
+	categoryID, err := parseOptionalUUID(c, "category_id")
+	if err != nil {
+		return nil, err
+	}
+	query.CategoryID = categoryID
+
+	for _, f := range c.QueryArray("f") {
+		filter, err := field.ParseFilter(f)
+		if err != nil {
+			return nil, err
+		}
+		query.Fields = append(query.Fields, filter)
+	}
+
+	return query, nil
+}
+
+// parseSearchQuery reads the search terms and the optional filters from the query string
+func parseSearchQuery(c *gin.Context) (*advertisement.SearchQuery, error) {
+	query := &advertisement.SearchQuery{
+		Text:     c.Query("q"),
+		Status:   advertisement.Status(c.Query("status")),
+		Currency: c.Query("currency"),
+	}
+
+	var err error
+	query.CategoryID, err = parseOptionalUUID(c, "category_id")
+	if err != nil {
+		return nil, err
+	}
+
+	query.MinPrice, err = parseOptionalInt(c, "min_price")
+	if err != nil {
+		return nil, err
+	}
+
+	query.MaxPrice, err = parseOptionalInt(c, "max_price")
+	if err != nil {
+		return nil, err
+	}
+
+	return query, nil
+}
+
+// parseNearbyQuery reads the center, given as lat and lng, and the radius_km
+// of the search from the query string
+func parseNearbyQuery(c *gin.Context) (*advertisement.NearbyQuery, error) {
+	query := &advertisement.NearbyQuery{
+		Status: advertisement.Status(c.Query("status")),
+	}
+
+	var err error
+	query.Center.Latitude, err = parseFloat(c, "lat")
+	if err != nil {
+		return nil, err
+	}
+
+	query.Center.Longitude, err = parseFloat(c, "lng")
+	if err != nil {
+		return nil, err
+	}
+
+	query.RadiusKm, err = parseFloat(c, "radius_km")
+	if err != nil {
+		return nil, err
+	}
+
+	return query, nil
+}
+
+func parseFloat(c *gin.Context, key string) (float64, error) {
+	n, err := strconv.ParseFloat(c.Query(key), 64)
+	if err != nil {
+		return 0, apierrors.BadRequest("invalid " + key)
+	}
+
+	return n, nil
+}
+
+func parseOptionalInt(c *gin.Context, key string) (*int64, error) {
+	value := c.Query(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, apierrors.BadRequest("invalid " + key)
+	}
+
+	return &n, nil
+}
+
+func parseOptionalUUID(c *gin.Context, key string) (*uuid.UUID, error) {
+	value := c.Query(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, apierrors.BadRequest("invalid " + key)
+	}
+
+	return &id, nil
+}
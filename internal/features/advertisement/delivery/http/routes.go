@@ -0,0 +1,23 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"go-api/internal/core/advertisement"
+	"go-api/internal/core/user"
+	"go-api/internal/middleware"
+)
+
+func MapAdvertisementRoutes(group *gin.RouterGroup, h advertisement.Handlers, mw *middleware.Manager) {
+	group.GET("", h.GetAdvertisements())
+	group.GET("/search", h.Search())
+	group.GET("/nearby", h.Nearby())
+	group.GET("/shared/:share_token", h.GetByShareToken())
+	group.GET("/:advertisement_id", mw.OptionalAuthSession(), h.GetByID())
+
+	group.Use(mw.AuthSession())
+	group.POST("", mw.RequirePermission(user.PermAdvertisementCreate), h.Create())
+	group.PUT("/:advertisement_id", mw.RequirePermission(user.PermAdvertisementUpdate), h.Update())
+	group.POST("/:advertisement_id/cancel", mw.RequirePermission(user.PermAdvertisementStatus), h.Cancel())
+	group.PATCH("/:advertisement_id/status", mw.RequirePermission(user.PermAdvertisementStatus), h.ChangeStatus())
+}
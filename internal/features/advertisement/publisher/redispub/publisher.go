@@ -0,0 +1,34 @@
+package redispub
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/redis/go-redis/v9"
+
+	"go-api/internal/core/advertisement"
+	"go-api/pkg/config"
+)
+
+type advertisementPublisher struct {
+	conn *redis.Client
+	cfg  *config.Config
+}
+
+// Advertisement redis publisher constructor
+func NewAdvertisementPublisher(c *redis.Client, cfg *config.Config) advertisement.Publisher {
+	return &advertisementPublisher{
+		conn: c,
+		cfg:  cfg,
+	}
+}
+
+// Publish the event on the configured redis channel
+func (p *advertisementPublisher) Publish(ctx context.Context, event *advertisement.Event) error {
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return p.conn.Publish(ctx, p.cfg.Events.Channel, eventBytes).Err()
+}
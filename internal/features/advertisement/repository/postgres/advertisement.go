@@ -0,0 +1,364 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/pgtype"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	"go-api/internal/core/advertisement"
+	"go-api/pkg/geo"
+	"go-api/pkg/utils"
+)
+
+type AdvertisementRepository struct {
+	conn *sqlx.DB
+}
+
+func NewAdvertisementRepository(db *sqlx.DB) advertisement.Repository {
+	return &AdvertisementRepository{
+		conn: db,
+	}
+}
+
+func (r *AdvertisementRepository) Create(
+	ctx context.Context,
+	ad *advertisement.Model,
+	values []*advertisement.FieldValue,
+) (*advertisement.Model, error) {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "AdvertisementRepository.Create.BeginTxx")
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	a := &advertisement.Model{}
+	err = tx.QueryRowxContext(
+		ctx,
+		createAdvertisementQuery,
+		ad.CostumerID,
+		ad.CategoryID,
+		ad.Title,
+		ad.Description,
+		ad.Status,
+		ad.Currency,
+		ad.Price,
+		ad.ExpirationDate,
+		ad.Location,
+		ad.Visibility,
+		ad.ShareToken,
+	).StructScan(a)
+	if err != nil {
+		return nil, errors.Wrap(err, "AdvertisementRepository.Create.StructScan")
+	}
+
+	err = createFieldValues(ctx, tx, a.ID, values)
+	if err != nil {
+		return nil, errors.Wrap(err, "AdvertisementRepository.Create.createFieldValues")
+	}
+
+	return a, errors.Wrap(tx.Commit(), "AdvertisementRepository.Create.Commit")
+}
+
+// Update stores the advertisement and, when values is not nil, replaces its field values
+func (r *AdvertisementRepository) Update(
+	ctx context.Context,
+	ad *advertisement.Model,
+	values []*advertisement.FieldValue,
+) (*advertisement.Model, error) {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "AdvertisementRepository.Update.BeginTxx")
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	a := &advertisement.Model{}
+	err = tx.GetContext(
+		ctx,
+		a,
+		updateAdvertisementQuery,
+		ad.ID,
+		ad.CategoryID,
+		ad.Title,
+		ad.Description,
+		ad.Currency,
+		ad.Price,
+		ad.ExpirationDate,
+		ad.Location,
+		ad.Visibility,
+		ad.ShareToken,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "AdvertisementRepository.Update.GetContext")
+	}
+
+	if values != nil {
+		_, err = tx.ExecContext(ctx, deleteAdvertisementFieldsQuery, a.ID)
+		if err != nil {
+			return nil, errors.Wrap(err, "AdvertisementRepository.Update.ExecContext")
+		}
+
+		err = createFieldValues(ctx, tx, a.ID, values)
+		if err != nil {
+			return nil, errors.Wrap(err, "AdvertisementRepository.Update.createFieldValues")
+		}
+	}
+
+	return a, errors.Wrap(tx.Commit(), "AdvertisementRepository.Update.Commit")
+}
+
+func (r *AdvertisementRepository) UpdateStatus(ctx context.Context, adID uuid.UUID, from, to advertisement.Status) (*advertisement.Model, error) {
+	a := &advertisement.Model{}
+	err := r.conn.GetContext(ctx, a, updateAdvertisementStatusQuery, adID, from, to)
+
+	return a, errors.Wrap(err, "AdvertisementRepository.UpdateStatus.GetContext")
+}
+
+// ExpireDue locks up to limit opened advertisements whose expiration date is
+// not after now and moves to expired the ones canExpire accepts. Rows locked
+// by another instance are skipped, so concurrent callers never expire the
+// same advertisement twice.
+func (r *AdvertisementRepository) ExpireDue(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+	canExpire func(ad *advertisement.Model) error,
+) ([]*advertisement.Model, error) {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "AdvertisementRepository.ExpireDue.BeginTxx")
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	due := make([]*advertisement.Model, 0, limit)
+	err = tx.SelectContext(ctx, &due, getDueAdvertisementsQuery, now, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "AdvertisementRepository.ExpireDue.SelectContext")
+	}
+
+	ads := make([]*advertisement.Model, 0, len(due))
+	for _, ad := range due {
+		if canExpire(ad) != nil {
+			continue
+		}
+
+		a := &advertisement.Model{}
+		err = tx.GetContext(ctx, a, updateAdvertisementStatusQuery, ad.ID, ad.Status, advertisement.StatusExpired)
+		if err != nil {
+			return nil, errors.Wrap(err, "AdvertisementRepository.ExpireDue.GetContext")
+		}
+		ads = append(ads, a)
+	}
+
+	return ads, errors.Wrap(tx.Commit(), "AdvertisementRepository.ExpireDue.Commit")
+}
+
+func (r *AdvertisementRepository) GetByID(ctx context.Context, adID uuid.UUID) (*advertisement.Model, error) {
+	a := &advertisement.Model{}
+	err := r.conn.QueryRowxContext(
+		ctx,
+		getAdvertisementByIDQuery,
+		adID,
+	).StructScan(a)
+
+	return a, errors.Wrap(err, "AdvertisementRepository.GetByID.StructScan")
+}
+
+// GetByShareToken returns the unlisted advertisement opened by the token
+func (r *AdvertisementRepository) GetByShareToken(ctx context.Context, token string) (*advertisement.Model, error) {
+	a := &advertisement.Model{}
+	err := r.conn.QueryRowxContext(
+		ctx,
+		getAdvertisementByShareTokenQuery,
+		token,
+	).StructScan(a)
+
+	return a, errors.Wrap(err, "AdvertisementRepository.GetByShareToken.StructScan")
+}
+
+// IsParticipant reports whether the user applied or was invited to the advertisement
+func (r *AdvertisementRepository) IsParticipant(ctx context.Context, adID, userID uuid.UUID) (bool, error) {
+	var participant bool
+	err := r.conn.GetContext(ctx, &participant, isParticipantQuery, adID, userID)
+
+	return participant, errors.Wrap(err, "AdvertisementRepository.IsParticipant.GetContext")
+}
+
+func (r *AdvertisementRepository) GetFieldValues(ctx context.Context, adID uuid.UUID) ([]*advertisement.FieldValue, error) {
+	values := make([]*advertisement.FieldValue, 0)
+	err := r.conn.SelectContext(ctx, &values, getAdvertisementFieldsQuery, adID)
+	if err != nil {
+		return nil, errors.Wrap(err, "AdvertisementRepository.GetFieldValues.SelectContext")
+	}
+
+	return values, nil
+}
+
+// GetPageFieldValues returns the field values of every advertisement of a
+// page in one query
+func (r *AdvertisementRepository) GetPageFieldValues(ctx context.Context, adIDs []uuid.UUID) ([]*advertisement.FieldValue, error) {
+	ids := make([]string, 0, len(adIDs))
+	for _, id := range adIDs {
+		ids = append(ids, id.String())
+	}
+
+	arg := &pgtype.UUIDArray{}
+	if err := arg.Set(ids); err != nil {
+		return nil, errors.Wrap(err, "AdvertisementRepository.GetPageFieldValues.Set")
+	}
+
+	values := make([]*advertisement.FieldValue, 0)
+	err := r.conn.SelectContext(ctx, &values, getPageFieldsQuery, arg)
+	if err != nil {
+		return nil, errors.Wrap(err, "AdvertisementRepository.GetPageFieldValues.SelectContext")
+	}
+
+	return values, nil
+}
+
+func (r *AdvertisementRepository) GetAdvertisements(
+	ctx context.Context,
+	query *advertisement.ListQuery,
+	pagination *utils.PaginationQuery,
+) (*advertisement.List, error) {
+	filters, filterArgs := buildFieldFilters(query.Fields, 1)
+	args := append([]any{query.CategoryID}, filterArgs...)
+
+	var totalCount int
+	err := r.conn.GetContext(ctx, &totalCount, getAdvertisementsCountQuery+filters, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "AdvertisementRepository.GetAdvertisements.GetContext")
+	}
+
+	ads := make([]*advertisement.Model, 0, pagination.GetSize())
+	adsList := &advertisement.List{
+		TotalCount:     totalCount,
+		TotalPages:     pagination.GetTotalPages(totalCount),
+		Page:           pagination.GetPage(),
+		Size:           pagination.GetSize(),
+		HasMore:        pagination.GetHasMore(totalCount),
+		Advertisements: &ads,
+	}
+
+	if totalCount == 0 {
+		return adsList, nil
+	}
+
+	page := fmt.Sprintf(advertisementsPageQuery, len(args)+1, len(args)+2)
+	args = append(args, pagination.GetOffset(), pagination.GetLimit())
+
+	err = r.conn.SelectContext(ctx, &ads, getAllAdvertisementsQuery+filters+page, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "AdvertisementRepository.GetAdvertisements.SelectContext")
+	}
+
+	adsList.Advertisements = &ads
+	return adsList, nil
+}
+
+func (r *AdvertisementRepository) Search(
+	ctx context.Context,
+	query *advertisement.SearchQuery,
+	pagination *utils.PaginationQuery,
+) (*advertisement.SearchList, error) {
+	args := []any{
+		query.Text,
+		query.CategoryID,
+		query.Status,
+		query.MinPrice,
+		query.MaxPrice,
+		query.Currency,
+	}
+
+	var totalCount int
+	err := r.conn.GetContext(ctx, &totalCount, searchAdvertisementsCountQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "AdvertisementRepository.Search.GetContext")
+	}
+
+	results := make([]*advertisement.SearchResult, 0, pagination.GetSize())
+	resultsList := &advertisement.SearchList{
+		TotalCount: totalCount,
+		TotalPages: pagination.GetTotalPages(totalCount),
+		Page:       pagination.GetPage(),
+		Size:       pagination.GetSize(),
+		HasMore:    pagination.GetHasMore(totalCount),
+		Results:    &results,
+	}
+
+	if totalCount == 0 {
+		return resultsList, nil
+	}
+
+	args = append(args, pagination.GetOffset(), pagination.GetLimit())
+	err = r.conn.SelectContext(ctx, &results, searchAdvertisementsQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "AdvertisementRepository.Search.SelectContext")
+	}
+
+	resultsList.Results = &results
+	return resultsList, nil
+}
+
+// Nearby lists the advertisements within the query radius, closest first
+func (r *AdvertisementRepository) Nearby(
+	ctx context.Context,
+	query *advertisement.NearbyQuery,
+	pagination *utils.PaginationQuery,
+) (*advertisement.NearbyList, error) {
+	box := geo.BoundingBox(query.Center, query.RadiusKm)
+	args := []any{
+		query.Center.Latitude,
+		query.Center.Longitude,
+		box.MinLatitude,
+		box.MaxLatitude,
+		box.MinLongitude,
+		box.MaxLongitude,
+		query.RadiusKm,
+		query.Status,
+	}
+
+	var totalCount int
+	err := r.conn.GetContext(ctx, &totalCount, nearbyAdvertisementsCountQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "AdvertisementRepository.Nearby.GetContext")
+	}
+
+	results := make([]*advertisement.NearbyResult, 0, pagination.GetSize())
+	resultsList := &advertisement.NearbyList{
+		TotalCount: totalCount,
+		TotalPages: pagination.GetTotalPages(totalCount),
+		Page:       pagination.GetPage(),
+		Size:       pagination.GetSize(),
+		HasMore:    pagination.GetHasMore(totalCount),
+		Results:    &results,
+	}
+
+	if totalCount == 0 {
+		return resultsList, nil
+	}
+
+	args = append(args, pagination.GetOffset(), pagination.GetLimit())
+	err = r.conn.SelectContext(ctx, &results, nearbyAdvertisementsQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "AdvertisementRepository.Nearby.SelectContext")
+	}
+
+	resultsList.Results = &results
+	return resultsList, nil
+}
+
+func createFieldValues(ctx context.Context, tx *sqlx.Tx, adID uuid.UUID, values []*advertisement.FieldValue) error {
+	for _, v := range values {
+		_, err := tx.ExecContext(ctx, createAdvertisementFieldQuery, adID, v.FieldID, v.Value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,560 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	"go-api/internal/core/advertisement"
+	"go-api/internal/core/field"
+	"go-api/internal/features/advertisement/repository/postgres"
+	"go-api/pkg/geo"
+	"go-api/pkg/utils"
+)
+
+func TestAdvertisementRepository_Create(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		value := &advertisement.FieldValue{FieldID: uuid.New(), Value: "truck"}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(createAdvertisementQuery).
+			WithArgs(
+				want.CostumerID,
+				want.CategoryID,
+				want.Title,
+				want.Description,
+				want.Status,
+				want.Currency,
+				want.Price,
+				want.ExpirationDate,
+				want.Location,
+				want.Visibility,
+				want.ShareToken,
+			).
+			WillReturnRows(rows)
+		mock.ExpectExec(createAdvertisementFieldQuery).
+			WithArgs(want.ID, value.FieldID, value.Value).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		got, err := repo.Create(context.TODO(), want, []*advertisement.FieldValue{value})
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Fail with invalid field rolls back", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		value := &advertisement.FieldValue{FieldID: uuid.New(), Value: "truck"}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(createAdvertisementQuery).WillReturnRows(rows)
+		mock.ExpectExec(createAdvertisementFieldQuery).
+			WithArgs(want.ID, value.FieldID, value.Value).
+			WillReturnError(sql.ErrConnDone)
+		mock.ExpectRollback()
+
+		got, err := repo.Create(context.TODO(), want, []*advertisement.FieldValue{value})
+		assert.ErrorIs(t, err, sql.ErrConnDone)
+		assert.Nil(t, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestAdvertisementRepository_Update(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(updateAdvertisementQuery).
+			WithArgs(
+				want.ID,
+				want.CategoryID,
+				want.Title,
+				want.Description,
+				want.Currency,
+				want.Price,
+				want.ExpirationDate,
+				want.Location,
+				want.Visibility,
+				want.ShareToken,
+			).
+			WillReturnRows(rows)
+		mock.ExpectCommit()
+
+		got, err := repo.Update(context.TODO(), want, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success replacing field values", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(updateAdvertisementQuery).WillReturnRows(rows)
+		mock.ExpectExec(deleteAdvertisementFieldsQuery).
+			WithArgs(want.ID).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		got, err := repo.Update(context.TODO(), want, []*advertisement.FieldValue{})
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestAdvertisementRepository_GetFieldValues(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, _ := setupTest(t)
+		defer db.Close()
+
+		value := &advertisement.FieldValue{
+			AdvertisementID: want.ID,
+			FieldID:         uuid.New(),
+			Name:            "hours",
+			Type:            field.TypeInteger,
+			Value:           "4",
+		}
+
+		rows := sqlmock.NewRows([]string{"advertisement_id", "field_id", "name", "type", "value"}).
+			AddRow(value.AdvertisementID, value.FieldID, value.Name, value.Type, value.Value)
+		mock.ExpectQuery(getAdvertisementFieldsQuery).
+			WithArgs(want.ID).
+			WillReturnRows(rows)
+
+		got, err := repo.GetFieldValues(context.TODO(), want.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, []*advertisement.FieldValue{value}, got)
+	})
+}
+
+func TestAdvertisementRepository_GetPageFieldValues(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, _ := setupTest(t)
+		defer db.Close()
+
+		other := uuid.New()
+		value := &advertisement.FieldValue{
+			AdvertisementID: want.ID,
+			FieldID:         uuid.New(),
+			Name:            "hours",
+			Type:            field.TypeInteger,
+			Value:           "4",
+		}
+
+		rows := sqlmock.NewRows([]string{"advertisement_id", "field_id", "name", "type", "value"}).
+			AddRow(value.AdvertisementID, value.FieldID, value.Name, value.Type, value.Value)
+		mock.ExpectQuery(getPageFieldsQuery).
+			WithArgs(fmt.Sprintf("{%s,%s}", want.ID, other)).
+			WillReturnRows(rows)
+
+		got, err := repo.GetPageFieldValues(context.TODO(), []uuid.UUID{want.ID, other})
+		assert.NoError(t, err)
+		assert.Equal(t, []*advertisement.FieldValue{value}, got)
+	})
+}
+
+func TestAdvertisementRepository_UpdateStatus(t *testing.T) {
+	t.Run("Fail with no rows", func(t *testing.T) {
+		db, repo, mock, want, _ := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(updateAdvertisementStatusQuery).
+			WithArgs(want.ID, advertisement.StatusOpened, advertisement.StatusCanceled).
+			WillReturnError(sql.ErrNoRows)
+
+		_, err := repo.UpdateStatus(context.TODO(), want.ID, advertisement.StatusOpened, advertisement.StatusCanceled)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestAdvertisementRepository_ExpireDue(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		now := time.Now()
+		mock.ExpectBegin()
+		mock.ExpectQuery(getDueAdvertisementsQuery).
+			WithArgs(now, 50).
+			WillReturnRows(rows)
+		mock.ExpectQuery(updateAdvertisementStatusQuery).
+			WithArgs(want.ID, advertisement.StatusOpened, advertisement.StatusExpired).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "status"}).AddRow(want.ID, advertisement.StatusExpired))
+		mock.ExpectCommit()
+
+		got, err := repo.ExpireDue(context.TODO(), now, 50, func(*advertisement.Model) error { return nil })
+		assert.NoError(t, err)
+		assert.Equal(t, []*advertisement.Model{{ID: want.ID, Status: advertisement.StatusExpired}}, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success skipping rejected advertisements", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		now := time.Now()
+		mock.ExpectBegin()
+		mock.ExpectQuery(getDueAdvertisementsQuery).
+			WithArgs(now, 50).
+			WillReturnRows(rows)
+		mock.ExpectCommit()
+
+		got, err := repo.ExpireDue(context.TODO(), now, 50, func(ad *advertisement.Model) error {
+			assert.Equal(t, want.ID, ad.ID)
+			return errors.New("not expirable")
+		})
+		assert.NoError(t, err)
+		assert.Empty(t, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestAdvertisementRepository_GetByID(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(getAdvertisementByIDQuery).
+			WithArgs(want.ID).
+			WillReturnRows(rows)
+
+		got, err := repo.GetByID(context.TODO(), want.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+}
+
+func TestAdvertisementRepository_GetByShareToken(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(getAdvertisementByShareTokenQuery).
+			WithArgs(*want.ShareToken).
+			WillReturnRows(rows)
+
+		got, err := repo.GetByShareToken(context.TODO(), *want.ShareToken)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("Fail with unknown token", func(t *testing.T) {
+		db, repo, mock, _, _ := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(getAdvertisementByShareTokenQuery).
+			WithArgs("unknown").
+			WillReturnError(sql.ErrNoRows)
+
+		_, err := repo.GetByShareToken(context.TODO(), "unknown")
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestAdvertisementRepository_IsParticipant(t *testing.T) {
+	t.Run("Success with participant", func(t *testing.T) {
+		db, repo, mock, want, _ := setupTest(t)
+		defer db.Close()
+
+		workerID := uuid.New()
+		mock.ExpectQuery(isParticipantQuery).
+			WithArgs(want.ID, workerID).
+			WillReturnRows(sqlmock.NewRows([]string{"?column?"}).AddRow(true))
+
+		got, err := repo.IsParticipant(context.TODO(), want.ID, workerID)
+		assert.NoError(t, err)
+		assert.True(t, got)
+	})
+
+	t.Run("Success with declined or expired invitation only", func(t *testing.T) {
+		db, repo, mock, want, _ := setupTest(t)
+		defer db.Close()
+
+		workerID := uuid.New()
+		mock.ExpectQuery(isParticipantQuery).
+			WithArgs(want.ID, workerID).
+			WillReturnRows(sqlmock.NewRows([]string{"?column?"}).AddRow(false))
+
+		got, err := repo.IsParticipant(context.TODO(), want.ID, workerID)
+		assert.NoError(t, err)
+		assert.False(t, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestAdvertisementRepository_GetAdvertisements(t *testing.T) {
+	pag := &utils.PaginationQuery{
+		Page: 0,
+		Size: 10,
+	}
+
+	t.Run("Success with advertisements", func(t *testing.T) {
+		db, repo, mock, _, rows := setupTest(t)
+		defer db.Close()
+
+		totalRows := sqlmock.NewRows([]string{"count"}).AddRow(1)
+		mock.ExpectQuery(getAdvertisementsCountQuery).
+			WithArgs(nil).
+			WillReturnRows(totalRows)
+		mock.ExpectQuery(getAllAdvertisementsQuery).
+			WithArgs(nil, pag.GetOffset(), pag.GetLimit()).
+			WillReturnRows(rows)
+
+		got, err := repo.GetAdvertisements(context.TODO(), &advertisement.ListQuery{}, pag)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(*got.Advertisements))
+	})
+
+	t.Run("Success with field filters", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		hoursID, vehicleID, toolsID := uuid.New(), uuid.New(), uuid.New()
+		query := &advertisement.ListQuery{
+			CategoryID: &want.CategoryID,
+			Fields: []*field.Filter{
+				{FieldID: hoursID, Type: field.TypeInteger, Operator: field.OperatorGte, Value: "4"},
+				{FieldID: vehicleID, Type: field.TypeSingleSelect, Operator: field.OperatorEq, Value: "truck"},
+				{FieldID: toolsID, Type: field.TypeText, Operator: field.OperatorContains, Value: "50%_off"},
+			},
+		}
+		args := []driver.Value{&want.CategoryID, hoursID, "4", vehicleID, "truck", toolsID, `50\%\_off`}
+
+		conditions := `af\.field_id = \$2 AND \(CASE WHEN af\.field_id = \$2 THEN af\.value::NUMERIC END\) >= \$3::NUMERIC` +
+			`[\s\S]*af\.field_id = \$4 AND af\.value = \$5` +
+			`[\s\S]*af\.field_id = \$6 AND af\.value ILIKE '%' \|\| \$7 \|\| '%'`
+
+		totalRows := sqlmock.NewRows([]string{"count"}).AddRow(1)
+		mock.ExpectQuery(getAdvertisementsCountQuery + `[\s\S]*` + conditions).
+			WithArgs(args...).
+			WillReturnRows(totalRows)
+		mock.ExpectQuery(getAllAdvertisementsQuery + `[\s\S]*` + conditions + `[\s\S]*OFFSET \$8\s+LIMIT \$9`).
+			WithArgs(append(args, pag.GetOffset(), pag.GetLimit())...).
+			WillReturnRows(rows)
+
+		got, err := repo.GetAdvertisements(context.TODO(), query, pag)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(*got.Advertisements))
+	})
+
+	t.Run("Success with no advertisements", func(t *testing.T) {
+		db, repo, mock, _, _ := setupTest(t)
+		defer db.Close()
+
+		totalRows := sqlmock.NewRows([]string{"count"}).AddRow(0)
+		mock.ExpectQuery(getAdvertisementsCountQuery).WillReturnRows(totalRows)
+
+		got, err := repo.GetAdvertisements(context.TODO(), &advertisement.ListQuery{}, pag)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(*got.Advertisements))
+	})
+}
+
+func TestAdvertisementRepository_Search(t *testing.T) {
+	pag := &utils.PaginationQuery{
+		Page: 1,
+		Size: 10,
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, _ := setupTest(t)
+		defer db.Close()
+
+		minPrice := int64(1000)
+		query := &advertisement.SearchQuery{
+			Text:       "pintura",
+			CategoryID: &want.CategoryID,
+			MinPrice:   &minPrice,
+			Currency:   "BRL",
+		}
+
+		totalRows := sqlmock.NewRows([]string{"count"}).AddRow(1)
+		mock.ExpectQuery(searchAdvertisementsCountQuery).
+			WithArgs(query.Text, query.CategoryID, query.Status, query.MinPrice, query.MaxPrice, query.Currency).
+			WillReturnRows(totalRows)
+
+		rows := sqlmock.NewRows([]string{"id", "title", "rank", "snippet"}).
+			AddRow(want.ID, want.Title, 0.6, "<mark>pintura</mark> de parede")
+		mock.ExpectQuery(searchAdvertisementsQuery).
+			WithArgs(query.Text, query.CategoryID, query.Status, query.MinPrice, query.MaxPrice, query.Currency,
+				pag.GetOffset(), pag.GetLimit()).
+			WillReturnRows(rows)
+
+		got, err := repo.Search(context.TODO(), query, pag)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, got.TotalCount)
+		assert.Len(t, *got.Results, 1)
+		assert.Equal(t, want.ID, (*got.Results)[0].ID)
+		assert.Equal(t, "<mark>pintura</mark> de parede", (*got.Results)[0].Snippet)
+	})
+
+	t.Run("Success without matches", func(t *testing.T) {
+		db, repo, mock, _, _ := setupTest(t)
+		defer db.Close()
+
+		query := &advertisement.SearchQuery{Text: "pintura"}
+
+		totalRows := sqlmock.NewRows([]string{"count"}).AddRow(0)
+		mock.ExpectQuery(searchAdvertisementsCountQuery).
+			WithArgs(query.Text, query.CategoryID, query.Status, query.MinPrice, query.MaxPrice, query.Currency).
+			WillReturnRows(totalRows)
+
+		got, err := repo.Search(context.TODO(), query, pag)
+		assert.NoError(t, err)
+		assert.Empty(t, *got.Results)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestAdvertisementRepository_Nearby(t *testing.T) {
+	pag := &utils.PaginationQuery{
+		Page: 1,
+		Size: 10,
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, _ := setupTest(t)
+		defer db.Close()
+
+		query := &advertisement.NearbyQuery{
+			Center:   geo.Point{Latitude: -23.56, Longitude: -46.64},
+			RadiusKm: 10,
+		}
+		box := geo.BoundingBox(query.Center, query.RadiusKm)
+		args := []driver.Value{
+			query.Center.Latitude,
+			query.Center.Longitude,
+			box.MinLatitude,
+			box.MaxLatitude,
+			box.MinLongitude,
+			box.MaxLongitude,
+			query.RadiusKm,
+			query.Status,
+		}
+
+		totalRows := sqlmock.NewRows([]string{"count"}).AddRow(1)
+		mock.ExpectQuery(nearbyAdvertisementsCountQuery).
+			WithArgs(args...).
+			WillReturnRows(totalRows)
+
+		rows := sqlmock.NewRows([]string{"id", "title", "distance_km"}).
+			AddRow(want.ID, want.Title, 1.4)
+		mock.ExpectQuery(nearbyAdvertisementsQuery).
+			WithArgs(append(args, pag.GetOffset(), pag.GetLimit())...).
+			WillReturnRows(rows)
+
+		got, err := repo.Nearby(context.TODO(), query, pag)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, got.TotalCount)
+		assert.Len(t, *got.Results, 1)
+		assert.Equal(t, want.ID, (*got.Results)[0].ID)
+		assert.Equal(t, 1.4, (*got.Results)[0].DistanceKm)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success without matches", func(t *testing.T) {
+		db, repo, mock, _, _ := setupTest(t)
+		defer db.Close()
+
+		query := &advertisement.NearbyQuery{
+			Center:   geo.Point{Latitude: -23.56, Longitude: -46.64},
+			RadiusKm: 10,
+		}
+
+		totalRows := sqlmock.NewRows([]string{"count"}).AddRow(0)
+		mock.ExpectQuery(nearbyAdvertisementsCountQuery).WillReturnRows(totalRows)
+
+		got, err := repo.Nearby(context.TODO(), query, pag)
+		assert.NoError(t, err)
+		assert.Empty(t, *got.Results)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func setupTest(t *testing.T) (*sql.DB, advertisement.Repository, sqlmock.Sqlmock, *advertisement.Model, *sqlmock.Rows) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	dbx := sqlx.NewDb(db, "sqlmock")
+	repo := postgres.NewAdvertisementRepository(dbx)
+
+	price := int64(15000)
+	shareToken := "fake-share-token"
+	want := &advertisement.Model{
+		ID:             uuid.New(),
+		CostumerID:     uuid.New(),
+		CategoryID:     uuid.New(),
+		Title:          "Fake title",
+		Description:    "fake description",
+		Status:         advertisement.StatusOpened,
+		Currency:       "BRL",
+		Price:          &price,
+		ExpirationDate: time.Now().UTC().Add(24 * time.Hour),
+		Location: &advertisement.Location{
+			City:    "São Paulo",
+			Country: "BR",
+			Point:   geo.Point{Latitude: -23.5505, Longitude: -46.6333},
+		},
+		Visibility: advertisement.VisibilityUnlisted,
+		ShareToken: &shareToken,
+		CreatedAt:  time.Now().UTC(),
+		UpdatedAt:  time.Now().UTC(),
+	}
+	location, err := json.Marshal(want.Location)
+	assert.NoError(t, err)
+
+	rows := sqlmock.NewRows([]string{
+		"id",
+		"costumer_id",
+		"category_id",
+		"title",
+		"description",
+		"status",
+		"currency",
+		"price",
+		"expiration_date",
+		"selected_cadidate",
+		"location",
+		"visibility",
+		"share_token",
+		"created_at",
+		"updated_at",
+	}).AddRow(
+		want.ID,
+		want.CostumerID,
+		want.CategoryID,
+		want.Title,
+		want.Description,
+		want.Status,
+		want.Currency,
+		*want.Price,
+		want.ExpirationDate,
+		nil,
+		location,
+		want.Visibility,
+		*want.ShareToken,
+		want.CreatedAt,
+		want.UpdatedAt,
+	)
+
+	return db, repo, mock, want, rows
+}
@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+
+	"go-api/internal/core/field"
+)
+
+var comparisons = map[field.Operator]string{
+	field.OperatorEq:  "=",
+	field.OperatorGt:  ">",
+	field.OperatorGte: ">=",
+	field.OperatorLt:  "<",
+	field.OperatorLte: "<=",
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// buildFieldFilters returns one EXISTS condition per filter and the values
+// they bind, numbering the placeholders after the given number of args.
+// Only the operators and casts come from the code; every value is bound.
+func buildFieldFilters(filters []*field.Filter, argCount int) (string, []any) {
+	var sb strings.Builder
+	args := make([]any, 0, 2*len(filters))
+
+	for _, f := range filters {
+		fieldArg := argCount + len(args) + 1
+		valueArg := fieldArg + 1
+
+		sb.WriteString(fmt.Sprintf(fieldFilterQuery, fieldArg, fieldCondition(f, fieldArg, valueArg)))
+
+		value := f.Value
+		if f.Type == field.TypeText && f.Operator == field.OperatorContains {
+			value = likeEscaper.Replace(value)
+		}
+		args = append(args, f.FieldID, value)
+	}
+
+	return sb.String(), args
+}
+
+// fieldCondition compares af.value with the filter value. Casts are guarded
+// by the field id so the values of other fields are never converted.
+func fieldCondition(f *field.Filter, fieldArg, valueArg int) string {
+	switch f.Type {
+	case field.TypeNumber, field.TypeInteger, field.TypeMoney:
+		return fmt.Sprintf(
+			"(CASE WHEN af.field_id = $%d THEN af.value::NUMERIC END) %s $%d::NUMERIC",
+			fieldArg, comparisons[f.Operator], valueArg,
+		)
+	case field.TypeDate:
+		return fmt.Sprintf(
+			"(CASE WHEN af.field_id = $%d THEN af.value::DATE END) %s $%d::DATE",
+			fieldArg, comparisons[f.Operator], valueArg,
+		)
+	case field.TypeMultiSelect:
+		return fmt.Sprintf(
+			"(CASE WHEN af.field_id = $%d THEN af.value::JSONB END) @> jsonb_build_array($%d::TEXT)",
+			fieldArg, valueArg,
+		)
+	default:
+		if f.Operator == field.OperatorContains {
+			return fmt.Sprintf("af.value ILIKE '%%' || $%d || '%%'", valueArg)
+		}
+		return fmt.Sprintf("af.value = $%d", valueArg)
+	}
+}
@@ -0,0 +1,190 @@
+package postgres_test
+
+const (
+	createAdvertisementQuery = `
+		INSERT INTO advertisements \(costumer_id, category_id, title, description, status, currency, price, expiration_date,
+			location, visibility, share_token\)
+		VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10, \$11\)
+		RETURNING id, costumer_id, category_id, title, description, status, currency, price, expiration_date,
+			selected_cadidate, location, visibility, share_token, accepted_price, created_at, updated_at
+	`
+
+	updateAdvertisementQuery = `
+		UPDATE advertisements
+		SET category_id = \$2,
+			title = \$3,
+			description = \$4,
+			currency = \$5,
+			price = \$6,
+			expiration_date = \$7,
+			location = \$8,
+			visibility = \$9,
+			share_token = \$10,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE id = \$1
+		RETURNING id, costumer_id, category_id, title, description, status, currency, price, expiration_date,
+			selected_cadidate, location, visibility, share_token, accepted_price, created_at, updated_at
+	`
+
+	updateAdvertisementStatusQuery = `
+		UPDATE advertisements
+		SET status = \$3,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE id = \$1 AND status = \$2
+		RETURNING id, costumer_id, category_id, title, description, status, currency, price, expiration_date,
+			selected_cadidate, location, visibility, share_token, accepted_price, created_at, updated_at
+	`
+
+	getDueAdvertisementsQuery = `
+		SELECT id, costumer_id, category_id, title, description, status, currency, price, expiration_date,
+			selected_cadidate, location, visibility, share_token, accepted_price, created_at, updated_at
+		FROM advertisements
+		WHERE status = 'opened' AND selected_cadidate IS NULL AND expiration_date <= \$1
+		ORDER BY expiration_date
+		LIMIT \$2
+		FOR UPDATE SKIP LOCKED
+	`
+
+	getAdvertisementByIDQuery = `
+		SELECT id, costumer_id, category_id, title, description, status, currency, price, expiration_date,
+			selected_cadidate, location, visibility, share_token, accepted_price, created_at, updated_at
+		FROM advertisements
+		WHERE id = \$1
+	`
+
+	getAdvertisementByShareTokenQuery = `
+		SELECT id, costumer_id, category_id, title, description, status, currency, price, expiration_date,
+			selected_cadidate, location, visibility, share_token, accepted_price, created_at, updated_at
+		FROM advertisements
+		WHERE share_token = \$1 AND visibility = 'unlisted'
+	`
+
+	isParticipantQuery = `
+		SELECT EXISTS \(
+			SELECT 1 FROM candidates WHERE advertisement_id = \$1 AND worker_id = \$2
+		\) OR EXISTS \(
+			SELECT 1 FROM invitations
+			WHERE advertisement_id = \$1 AND worker_id = \$2 AND status IN \('pending', 'accepted'\)
+		\)
+	`
+
+	deleteAdvertisementFieldsQuery = `DELETE FROM advertisement_field WHERE advertisement_id = \$1`
+
+	createAdvertisementFieldQuery = `
+		INSERT INTO advertisement_field \(advertisement_id, field_id, value\)
+		VALUES \(\$1, \$2, \$3\)
+	`
+
+	getAdvertisementFieldsQuery = `
+		SELECT af\.advertisement_id, af\.field_id, f\.name, f\.type, COALESCE\(af\.value, ''\) AS value
+		FROM advertisement_field af
+		JOIN fields f ON f\.id = af\.field_id
+		WHERE af\.advertisement_id = \$1
+		ORDER BY f\.name
+	`
+
+	getPageFieldsQuery = `
+		SELECT af\.advertisement_id, af\.field_id, f\.name, f\.type, COALESCE\(af\.value, ''\) AS value
+		FROM advertisement_field af
+		JOIN fields f ON f\.id = af\.field_id
+		WHERE af\.advertisement_id = ANY\(\$1\)
+		ORDER BY af\.advertisement_id, f\.name
+	`
+
+	// The listing queries are completed with one fieldFilterQuery per field
+	// filter and with advertisementsPageQuery
+	getAdvertisementsCountQuery = `
+		SELECT COUNT\(a\.id\)
+		FROM advertisements a
+		WHERE a\.visibility = 'public'
+			AND \(\$1::UUID IS NULL OR a\.category_id = \$1\)
+	`
+
+	getAllAdvertisementsQuery = `
+		SELECT a\.id, a\.costumer_id, a\.category_id, a\.title, a\.description, a\.status, a\.currency, a\.price,
+			a\.expiration_date, a\.selected_cadidate, a\.location, a\.visibility, a\.share_token, a\.accepted_price, a\.created_at, a\.updated_at
+		FROM advertisements a
+		WHERE a\.visibility = 'public'
+			AND \(\$1::UUID IS NULL OR a\.category_id = \$1\)
+	`
+
+	fieldFilterQuery = `
+		AND EXISTS \(
+			SELECT 1
+			FROM advertisement_field af
+			WHERE af\.advertisement_id = a\.id AND af\.field_id = \$%d AND %s
+		\)
+	`
+
+	advertisementsPageQuery = `
+		ORDER BY a\.created_at DESC
+		OFFSET \$%d
+		LIMIT \$%d
+	`
+
+	searchAdvertisementsCountQuery = `
+		SELECT COUNT\(a\.id\)
+		FROM advertisements a
+		WHERE a\.search_vector @@ websearch_to_tsquery\('portuguese', \$1\)
+			AND a\.visibility = 'public'
+			AND \(\$2::UUID IS NULL OR a\.category_id = \$2\)
+			AND \(\$3::TEXT = '' OR a\.status::TEXT = \$3\)
+			AND \(\$4::BIGINT IS NULL OR a\.price >= \$4\)
+			AND \(\$5::BIGINT IS NULL OR a\.price <= \$5\)
+			AND \(\$6::TEXT = '' OR a\.currency = \$6\)
+	`
+
+	// The description is HTML escaped before ts_headline adds the <mark>
+	// tags, so the snippet is safe to render as HTML
+	searchAdvertisementsQuery = `
+		SELECT a\.id, a\.costumer_id, a\.category_id, a\.title, a\.description, a\.status, a\.currency, a\.price,
+			a\.expiration_date, a\.selected_cadidate, a\.location, a\.visibility, a\.share_token, a\.accepted_price, a\.created_at, a\.updated_at,
+			ts_rank\(a\.search_vector, q\.query\) AS rank,
+			ts_headline\(
+				'portuguese',
+				replace\(replace\(replace\(a\.description, '&', '&amp;'\), '<', '&lt;'\), '>', '&gt;'\),
+				q\.query,
+				'StartSel=<mark>, StopSel=</mark>, MaxFragments=2'
+			\) AS snippet
+		FROM advertisements a, websearch_to_tsquery\('portuguese', \$1\) AS q\(query\)
+		WHERE a\.search_vector @@ q\.query
+			AND a\.visibility = 'public'
+			AND \(\$2::UUID IS NULL OR a\.category_id = \$2\)
+			AND \(\$3::TEXT = '' OR a\.status::TEXT = \$3\)
+			AND \(\$4::BIGINT IS NULL OR a\.price >= \$4\)
+			AND \(\$5::BIGINT IS NULL OR a\.price <= \$5\)
+			AND \(\$6::TEXT = '' OR a\.currency = \$6\)
+		ORDER BY rank DESC, a\.created_at DESC
+		OFFSET \$7
+		LIMIT \$8
+	`
+
+	// The bounding box in $3-$6 discards far away rows through the coordinates
+	// index before the exact distance is computed
+	nearbyAdvertisementsCountQuery = `
+		SELECT COUNT\(a\.id\)
+		FROM advertisements a
+		WHERE a\.location IS NOT NULL
+			AND a\.visibility = 'public'
+			AND a\.latitude BETWEEN \$3 AND \$4
+			AND a\.longitude BETWEEN \$5 AND \$6
+			AND earth_distance_km\(\$1, \$2, a\.latitude, a\.longitude\) <= \$7
+			AND \(\$8::TEXT = '' OR a\.status::TEXT = \$8\)
+	`
+
+	nearbyAdvertisementsQuery = `
+		SELECT a\.id, a\.costumer_id, a\.category_id, a\.title, a\.description, a\.status, a\.currency, a\.price,
+			a\.expiration_date, a\.selected_cadidate, a\.location, a\.visibility, a\.share_token, a\.accepted_price, a\.created_at, a\.updated_at,
+			earth_distance_km\(\$1, \$2, a\.latitude, a\.longitude\) AS distance_km
+		FROM advertisements a
+		WHERE a\.location IS NOT NULL
+			AND a\.visibility = 'public'
+			AND a\.latitude BETWEEN \$3 AND \$4
+			AND a\.longitude BETWEEN \$5 AND \$6
+			AND earth_distance_km\(\$1, \$2, a\.latitude, a\.longitude\) <= \$7
+			AND \(\$8::TEXT = '' OR a\.status::TEXT = \$8\)
+		ORDER BY distance_km, a\.created_at DESC
+		OFFSET \$9
+		LIMIT \$10
+	`
+)
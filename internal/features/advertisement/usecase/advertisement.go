@@ -0,0 +1,447 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"go-api/internal/core/advertisement"
+	"go-api/internal/core/category"
+	"go-api/internal/core/field"
+	"go-api/internal/core/user"
+	"go-api/pkg/apierrors"
+	"go-api/pkg/config"
+	"go-api/pkg/utils"
+)
+
+type advertisementUseCase struct {
+	cfg          *config.Config
+	repo         advertisement.Repository
+	categoryRepo category.Repository
+	fieldRepo    field.Repository
+}
+
+func NewAdvertisementUseCase(
+	cfg *config.Config,
+	repo advertisement.Repository,
+	categoryRepo category.Repository,
+	fieldRepo field.Repository,
+) advertisement.UseCase {
+	return &advertisementUseCase{
+		cfg:          cfg,
+		repo:         repo,
+		categoryRepo: categoryRepo,
+		fieldRepo:    fieldRepo,
+	}
+}
+
+func (uc *advertisementUseCase) Create(ctx context.Context, ad *advertisement.Model) (*advertisement.Model, error) {
+	usr, err := user.RequireRole(ctx, user.RoleCostumer)
+	if err != nil {
+		return nil, err
+	}
+
+	ad.CostumerID = usr.ID
+	ad.Status = advertisement.StatusOpened
+	ad.SelectedCandidate = nil
+	ad.ShareToken = nil
+	if ad.Visibility == "" {
+		ad.Visibility = advertisement.VisibilityPublic
+	}
+
+	err = ad.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = setShareToken(ad)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = uc.categoryRepo.GetByID(ctx, ad.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := uc.validateFields(ctx, ad.CategoryID, ad.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAd, err := uc.repo.Create(ctx, ad, values)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAd.SetFieldValues(values)
+	return createdAd, nil
+}
+
+func (uc *advertisementUseCase) Update(ctx context.Context, ad *advertisement.Model) (*advertisement.Model, error) {
+	current, err := uc.getOwnedAdvertisement(ctx, ad.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if current.Status != advertisement.StatusOpened {
+		return nil, apierrors.Conflict("only opened advertisements can be updated")
+	}
+
+	merged := mergeAdvertisement(current, ad)
+	err = merged.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = setShareToken(merged)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryChanged := merged.CategoryID != current.CategoryID
+	if categoryChanged {
+		_, err = uc.categoryRepo.GetByID(ctx, merged.CategoryID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The stored values only need to be checked again when they are replaced
+	// or when they may no longer belong to the advertisement category
+	var values []*advertisement.FieldValue
+	if ad.Fields != nil || categoryChanged {
+		values, err = uc.validateFields(ctx, merged.CategoryID, ad.Fields)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	updatedAd, err := uc.repo.Update(ctx, merged, values)
+	if err != nil {
+		return nil, err
+	}
+
+	if values == nil {
+		values, err = uc.repo.GetFieldValues(ctx, updatedAd.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	updatedAd.SetFieldValues(values)
+	return updatedAd, nil
+}
+
+func (uc *advertisementUseCase) Cancel(ctx context.Context, adID uuid.UUID) (*advertisement.Model, error) {
+	return uc.ChangeStatus(ctx, adID, advertisement.StatusCanceled)
+}
+
+func (uc *advertisementUseCase) ChangeStatus(
+	ctx context.Context,
+	adID uuid.UUID,
+	to advertisement.Status,
+) (*advertisement.Model, error) {
+	usr, err := user.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := uc.repo.GetByID(ctx, adID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkTransition(current, to, actorsOf(usr, current))
+	if err != nil {
+		return nil, err
+	}
+
+	// The update only applies while the status is still the one checked above
+	updatedAd, err := uc.repo.UpdateStatus(ctx, current.ID, current.Status, to)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, invalidTransition(current.Status, to, "advertisement status changed, try again")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedAd, nil
+}
+
+// Expire moves up to limit due advertisements to expired as the system
+// actor, so the lifecycle transitions decide which of them may expire
+func (uc *advertisementUseCase) Expire(ctx context.Context, now time.Time, limit int) ([]*advertisement.Model, error) {
+	return uc.repo.ExpireDue(ctx, now, limit, func(ad *advertisement.Model) error {
+		return checkTransition(ad, advertisement.StatusExpired, actorSystem)
+	})
+}
+
+func (uc *advertisementUseCase) GetByID(ctx context.Context, adID uuid.UUID) (*advertisement.Model, error) {
+	ad, err := uc.repo.GetByID(ctx, adID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = advertisement.CheckVisible(ctx, uc.repo, ad, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.withFieldValues(ctx, ad)
+}
+
+// GetByShareToken returns the unlisted advertisement opened by the token to anyone holding it
+func (uc *advertisementUseCase) GetByShareToken(ctx context.Context, token string) (*advertisement.Model, error) {
+	ad, err := uc.repo.GetByShareToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.withFieldValues(ctx, ad)
+}
+
+func (uc *advertisementUseCase) GetAdvertisements(
+	ctx context.Context,
+	query *advertisement.ListQuery,
+	pq *utils.PaginationQuery,
+) (*advertisement.List, error) {
+	if len(query.Fields) > 0 {
+		if query.CategoryID == nil {
+			return nil, apierrors.BadRequest("category_id is required to filter by fields")
+		}
+
+		fields, err := uc.fieldRepo.GetByCategory(ctx, *query.CategoryID)
+		if err != nil {
+			return nil, err
+		}
+
+		query.Fields, err = field.ResolveFilters(fields, query.Fields)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	list, err := uc.repo.GetAdvertisements(ctx, query, pq)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.withPageFieldValues(ctx, *list.Advertisements)
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (uc *advertisementUseCase) Search(
+	ctx context.Context,
+	query *advertisement.SearchQuery,
+	pq *utils.PaginationQuery,
+) (*advertisement.SearchList, error) {
+	err := query.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := uc.repo.Search(ctx, query, pq)
+	if err != nil {
+		return nil, err
+	}
+
+	ads := make([]*advertisement.Model, 0, len(*list.Results))
+	for _, r := range *list.Results {
+		ads = append(ads, &r.Model)
+	}
+
+	err = uc.withPageFieldValues(ctx, ads)
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (uc *advertisementUseCase) Nearby(
+	ctx context.Context,
+	query *advertisement.NearbyQuery,
+	pq *utils.PaginationQuery,
+) (*advertisement.NearbyList, error) {
+	err := query.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := uc.repo.Nearby(ctx, query, pq)
+	if err != nil {
+		return nil, err
+	}
+
+	ads := make([]*advertisement.Model, 0, len(*list.Results))
+	for _, r := range *list.Results {
+		ads = append(ads, &r.Model)
+	}
+
+	err = uc.withPageFieldValues(ctx, ads)
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (uc *advertisementUseCase) getOwnedAdvertisement(ctx context.Context, adID uuid.UUID) (*advertisement.Model, error) {
+	usr, err := user.RequireRole(ctx, user.RoleCostumer)
+	if err != nil {
+		return nil, err
+	}
+
+	ad, err := uc.repo.GetByID(ctx, adID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !usr.CanActOn(ad.CostumerID) {
+		return nil, apierrors.Forbidden()
+	}
+
+	return ad, nil
+}
+
+// withFieldValues loads the field values of an advertisement being read and
+// hides its share token from everyone but the owner and admins
+func (uc *advertisementUseCase) withFieldValues(ctx context.Context, ad *advertisement.Model) (*advertisement.Model, error) {
+	values, err := uc.repo.GetFieldValues(ctx, ad.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	usr, err := user.GetUserFromCtx(ctx)
+	if err != nil || !usr.CanActOn(ad.CostumerID, user.PermAdvertisementManage) {
+		ad.ShareToken = nil
+	}
+
+	ad.SetFieldValues(values)
+	return ad, nil
+}
+
+// withPageFieldValues loads the field values of a page of advertisements
+// with a single query
+func (uc *advertisementUseCase) withPageFieldValues(ctx context.Context, ads []*advertisement.Model) error {
+	if len(ads) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(ads))
+	for _, ad := range ads {
+		ids = append(ids, ad.ID)
+	}
+
+	values, err := uc.repo.GetPageFieldValues(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	byAd := make(map[uuid.UUID][]*advertisement.FieldValue, len(ads))
+	for _, v := range values {
+		byAd[v.AdvertisementID] = append(byAd[v.AdvertisementID], v)
+	}
+
+	for _, ad := range ads {
+		ad.SetFieldValues(byAd[ad.ID])
+	}
+
+	return nil
+}
+
+// setShareToken gives unlisted advertisements a share token, keeping the one
+// they already have, and removes it from every other visibility
+func setShareToken(ad *advertisement.Model) error {
+	if ad.Visibility != advertisement.VisibilityUnlisted {
+		ad.ShareToken = nil
+		return nil
+	}
+
+	if ad.ShareToken != nil {
+		return nil
+	}
+
+	token, err := advertisement.NewShareToken()
+	if err != nil {
+		return errors.Wrap(err, "advertisementUseCase.setShareToken.NewShareToken")
+	}
+
+	ad.ShareToken = &token
+	return nil
+}
+
+// validateFields checks the dynamic field values against the field
+// definitions of the category and returns them in storage form
+func (uc *advertisementUseCase) validateFields(
+	ctx context.Context,
+	categoryID uuid.UUID,
+	values map[string]any,
+) ([]*advertisement.FieldValue, error) {
+	fields, err := uc.fieldRepo.GetByCategory(ctx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := field.ValidateValues(fields, values)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldValues := make([]*advertisement.FieldValue, 0, len(stored))
+	for _, f := range fields {
+		value, ok := stored[f.ID]
+		if !ok {
+			continue
+		}
+
+		fieldValues = append(fieldValues, &advertisement.FieldValue{
+			FieldID: f.ID,
+			Name:    f.Name,
+			Type:    f.Type,
+			Value:   value,
+		})
+	}
+
+	return fieldValues, nil
+}
+
+// mergeAdvertisement applies the fields sent on an update over the stored advertisement
+func mergeAdvertisement(current, ad *advertisement.Model) *advertisement.Model {
+	merged := *current
+
+	if ad.CategoryID != uuid.Nil {
+		merged.CategoryID = ad.CategoryID
+	}
+	if ad.Title != "" {
+		merged.Title = ad.Title
+	}
+	if ad.Description != "" {
+		merged.Description = ad.Description
+	}
+	if ad.Currency != "" {
+		merged.Currency = ad.Currency
+	}
+	if ad.Price != nil {
+		merged.Price = ad.Price
+	}
+	if !ad.ExpirationDate.Equal(time.Time{}) {
+		merged.ExpirationDate = ad.ExpirationDate
+	}
+	if ad.Location != nil {
+		merged.Location = ad.Location
+	}
+	if ad.Visibility != "" {
+		merged.Visibility = ad.Visibility
+	}
+
+	return &merged
+}
@@ -0,0 +1,748 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"go-api/internal/core/advertisement"
+	advertisementmock "go-api/internal/core/advertisement/mocks"
+	"go-api/internal/core/category"
+	categorymock "go-api/internal/core/category/mocks"
+	"go-api/internal/core/field"
+	fieldmock "go-api/internal/core/field/mocks"
+	"go-api/internal/core/user"
+	"go-api/internal/features/advertisement/usecase"
+	"go-api/pkg/apierrors"
+	"go-api/pkg/config"
+	"go-api/pkg/geo"
+	"go-api/pkg/utils"
+)
+
+func TestAdvertisementUseCase_Create(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleCostumer)
+
+		ad := fakeAdvertisement()
+		ad.Fields = map[string]any{"hours": float64(4), "vehicle_type": "truck"}
+		fields := fakeFields(ad.CategoryID)
+
+		m.categoryRepo.On("GetByID", ctx, ad.CategoryID).
+			Return(&category.Model{ID: ad.CategoryID}, nil).
+			Once()
+
+		m.fieldRepo.On("GetByCategory", ctx, ad.CategoryID).
+			Return(fields, nil).
+			Once()
+
+		m.repo.On("Create", ctx, ad, []*advertisement.FieldValue{
+			{FieldID: fields[0].ID, Name: "hours", Type: field.TypeInteger, Value: "4"},
+			{FieldID: fields[1].ID, Name: "vehicle_type", Type: field.TypeSingleSelect, Value: "truck"},
+		}).
+			Return(ad, nil).
+			Once()
+
+		got, err := uc.Create(ctx, ad)
+		assert.NoError(t, err)
+		assert.Equal(t, usr.ID, got.CostumerID)
+		assert.Equal(t, advertisement.StatusOpened, got.Status)
+		assert.Equal(t, map[string]any{"hours": int64(4), "vehicle_type": "truck"}, got.Fields)
+	})
+
+	t.Run("Success generating share token of unlisted advertisement", func(t *testing.T) {
+		ctx, _, m, uc := setupTest(t, user.RoleCostumer)
+
+		ad := fakeAdvertisement()
+		ad.Visibility = advertisement.VisibilityUnlisted
+
+		m.categoryRepo.On("GetByID", ctx, ad.CategoryID).
+			Return(&category.Model{ID: ad.CategoryID}, nil).
+			Once()
+
+		m.fieldRepo.On("GetByCategory", ctx, ad.CategoryID).
+			Return([]*field.Model{}, nil).
+			Once()
+
+		m.repo.On("Create", ctx, mock.MatchedBy(func(ad *advertisement.Model) bool {
+			return ad.ShareToken != nil && len(*ad.ShareToken) == 43
+		}), []*advertisement.FieldValue{}).
+			Return(ad, nil).
+			Once()
+
+		got, err := uc.Create(ctx, ad)
+		assert.NoError(t, err)
+		assert.Equal(t, advertisement.VisibilityUnlisted, got.Visibility)
+	})
+
+	t.Run("Fail with unknown currency", func(t *testing.T) {
+		ctx, _, _, uc := setupTest(t, user.RoleCostumer)
+
+		ad := fakeAdvertisement()
+		ad.Currency = "xyz"
+
+		got, err := uc.Create(ctx, ad)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusBadRequest, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with invalid visibility", func(t *testing.T) {
+		ctx, _, _, uc := setupTest(t, user.RoleCostumer)
+
+		ad := fakeAdvertisement()
+		ad.Visibility = "secret"
+
+		got, err := uc.Create(ctx, ad)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusBadRequest, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with every invalid field", func(t *testing.T) {
+		ctx, _, m, uc := setupTest(t, user.RoleCostumer)
+
+		ad := fakeAdvertisement()
+		ad.Fields = map[string]any{"hours": "four", "color": "red"}
+
+		m.categoryRepo.On("GetByID", ctx, ad.CategoryID).
+			Return(&category.Model{ID: ad.CategoryID}, nil).
+			Once()
+
+		m.fieldRepo.On("GetByCategory", ctx, ad.CategoryID).
+			Return(fakeFields(ad.CategoryID), nil).
+			Once()
+
+		got, err := uc.Create(ctx, ad)
+		assert.Nil(t, got)
+		apiErr := apierrors.Parse(err)
+		assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode())
+		assert.Equal(t, []field.ValueError{
+			{Field: "color", Message: "field does not belong to the category"},
+			{Field: "hours", Message: "must be a number"},
+			{Field: "vehicle_type", Message: "value is required"},
+		}, apiErr.Details)
+	})
+
+	t.Run("Fail with worker", func(t *testing.T) {
+		ctx, _, _, uc := setupTest(t, user.RoleWorker)
+
+		got, err := uc.Create(ctx, fakeAdvertisement())
+		assert.Error(t, err)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusForbidden, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with past expiration date", func(t *testing.T) {
+		ctx, _, _, uc := setupTest(t, user.RoleCostumer)
+
+		ad := fakeAdvertisement()
+		ad.ExpirationDate = time.Now().Add(-time.Hour)
+
+		got, err := uc.Create(ctx, ad)
+		assert.Error(t, err)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusBadRequest, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with invalid location", func(t *testing.T) {
+		ctx, _, _, uc := setupTest(t, user.RoleCostumer)
+
+		locations := []*advertisement.Location{
+			{Country: "BR", Point: geo.Point{Latitude: -23.55, Longitude: -46.63}},
+			{City: "São Paulo", Country: "Brasil", Point: geo.Point{Latitude: -23.55, Longitude: -46.63}},
+			{City: "São Paulo", Country: "BR", Point: geo.Point{Latitude: -123.55, Longitude: -46.63}},
+		}
+
+		for _, location := range locations {
+			ad := fakeAdvertisement()
+			ad.Location = location
+
+			got, err := uc.Create(ctx, ad)
+			assert.Nil(t, got)
+			assert.Equal(t, http.StatusBadRequest, apierrors.Parse(err).StatusCode())
+		}
+	})
+}
+
+func TestAdvertisementUseCase_Update(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleCostumer)
+
+		current := fakeAdvertisement()
+		current.ID = uuid.New()
+		current.CostumerID = usr.ID
+		current.Status = advertisement.StatusOpened
+
+		m.repo.On("GetByID", ctx, current.ID).
+			Return(current, nil).
+			Once()
+
+		m.repo.On("Update", ctx, mock.MatchedBy(func(ad *advertisement.Model) bool {
+			return ad.Title == "New title" && ad.Description == current.Description
+		}), []*advertisement.FieldValue(nil)).
+			Return(current, nil).
+			Once()
+
+		m.repo.On("GetFieldValues", ctx, current.ID).
+			Return([]*advertisement.FieldValue{}, nil).
+			Once()
+
+		got, err := uc.Update(ctx, &advertisement.Model{ID: current.ID, Title: "New title"})
+		assert.NoError(t, err)
+		assert.NotNil(t, got)
+	})
+
+	t.Run("Fail with another owner", func(t *testing.T) {
+		ctx, _, m, uc := setupTest(t, user.RoleCostumer)
+
+		current := fakeAdvertisement()
+		current.ID = uuid.New()
+		current.CostumerID = uuid.New()
+
+		m.repo.On("GetByID", ctx, current.ID).
+			Return(current, nil).
+			Once()
+
+		got, err := uc.Update(ctx, &advertisement.Model{ID: current.ID, Title: "New title"})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusForbidden, apierrors.Parse(err).StatusCode())
+	})
+}
+
+func TestAdvertisementUseCase_Cancel(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleCostumer)
+
+		current := fakeAdvertisement()
+		current.ID = uuid.New()
+		current.CostumerID = usr.ID
+		current.Status = advertisement.StatusOpened
+
+		m.repo.On("GetByID", ctx, current.ID).
+			Return(current, nil).
+			Once()
+
+		m.repo.On("UpdateStatus", ctx, current.ID, advertisement.StatusOpened, advertisement.StatusCanceled).
+			Return(current, nil).
+			Once()
+
+		got, err := uc.Cancel(ctx, current.ID)
+		assert.NoError(t, err)
+		assert.NotNil(t, got)
+	})
+
+	t.Run("Fail with concurrent status change", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleCostumer)
+
+		current := fakeAdvertisement()
+		current.ID = uuid.New()
+		current.CostumerID = usr.ID
+		current.Status = advertisement.StatusOpened
+
+		m.repo.On("GetByID", ctx, current.ID).
+			Return(current, nil).
+			Once()
+
+		m.repo.On("UpdateStatus", ctx, current.ID, advertisement.StatusOpened, advertisement.StatusCanceled).
+			Return(nil, sql.ErrNoRows).
+			Once()
+
+		got, err := uc.Cancel(ctx, current.ID)
+		assert.Error(t, err)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusConflict, apierrors.Parse(err).StatusCode())
+		assert.Equal(t, advertisement.ErrCodeInvalidTransition, apierrors.Parse(err).ErrCode)
+	})
+}
+
+func TestAdvertisementUseCase_ChangeStatus(t *testing.T) {
+	t.Run("Success completing as selected worker", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleWorker)
+
+		current := fakeAdvertisement()
+		current.ID = uuid.New()
+		current.Status = advertisement.StatusOpened
+		current.SelectedCandidate = &usr.ID
+
+		m.repo.On("GetByID", ctx, current.ID).
+			Return(current, nil).
+			Once()
+
+		m.repo.On("UpdateStatus", ctx, current.ID, advertisement.StatusOpened, advertisement.StatusCompleted).
+			Return(current, nil).
+			Once()
+
+		got, err := uc.ChangeStatus(ctx, current.ID, advertisement.StatusCompleted)
+		assert.NoError(t, err)
+		assert.NotNil(t, got)
+	})
+
+	t.Run("Success canceling as admin", func(t *testing.T) {
+		ctx, _, m, uc := setupTest(t, user.RoleAdmin)
+
+		current := fakeAdvertisement()
+		current.ID = uuid.New()
+		current.Status = advertisement.StatusOpened
+
+		m.repo.On("GetByID", ctx, current.ID).
+			Return(current, nil).
+			Once()
+
+		m.repo.On("UpdateStatus", ctx, current.ID, advertisement.StatusOpened, advertisement.StatusCanceled).
+			Return(current, nil).
+			Once()
+
+		got, err := uc.ChangeStatus(ctx, current.ID, advertisement.StatusCanceled)
+		assert.NoError(t, err)
+		assert.NotNil(t, got)
+	})
+
+	t.Run("Fail completing without selected candidate", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleCostumer)
+
+		current := fakeAdvertisement()
+		current.ID = uuid.New()
+		current.CostumerID = usr.ID
+		current.Status = advertisement.StatusOpened
+
+		m.repo.On("GetByID", ctx, current.ID).
+			Return(current, nil).
+			Once()
+
+		got, err := uc.ChangeStatus(ctx, current.ID, advertisement.StatusCompleted)
+		assert.Nil(t, got)
+		apiErr := apierrors.Parse(err)
+		assert.Equal(t, http.StatusConflict, apiErr.StatusCode())
+		assert.Equal(t, advertisement.ErrCodeInvalidTransition, apiErr.ErrCode)
+	})
+
+	t.Run("Fail leaving a final status", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleCostumer)
+
+		current := fakeAdvertisement()
+		current.ID = uuid.New()
+		current.CostumerID = usr.ID
+		current.Status = advertisement.StatusCanceled
+
+		m.repo.On("GetByID", ctx, current.ID).
+			Return(current, nil).
+			Once()
+
+		got, err := uc.ChangeStatus(ctx, current.ID, advertisement.StatusOpened)
+		assert.Nil(t, got)
+		apiErr := apierrors.Parse(err)
+		assert.Equal(t, http.StatusConflict, apiErr.StatusCode())
+		assert.Equal(t, advertisement.ErrCodeInvalidTransition, apiErr.ErrCode)
+	})
+
+	t.Run("Fail completing as another worker", func(t *testing.T) {
+		ctx, _, m, uc := setupTest(t, user.RoleWorker)
+
+		selected := uuid.New()
+		current := fakeAdvertisement()
+		current.ID = uuid.New()
+		current.Status = advertisement.StatusOpened
+		current.SelectedCandidate = &selected
+
+		m.repo.On("GetByID", ctx, current.ID).
+			Return(current, nil).
+			Once()
+
+		got, err := uc.ChangeStatus(ctx, current.ID, advertisement.StatusCompleted)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusForbidden, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail expiring as owner", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleCostumer)
+
+		current := fakeAdvertisement()
+		current.ID = uuid.New()
+		current.CostumerID = usr.ID
+		current.Status = advertisement.StatusOpened
+
+		m.repo.On("GetByID", ctx, current.ID).
+			Return(current, nil).
+			Once()
+
+		got, err := uc.ChangeStatus(ctx, current.ID, advertisement.StatusExpired)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusForbidden, apierrors.Parse(err).StatusCode())
+	})
+}
+
+func TestAdvertisementUseCase_Expire(t *testing.T) {
+	t.Run("Success checking the lifecycle as system", func(t *testing.T) {
+		ctx, _, m, uc := setupTest(t, user.RoleAdmin)
+
+		due := fakeAdvertisement()
+		due.Status = advertisement.StatusOpened
+		due.ExpirationDate = time.Now().Add(-time.Hour)
+
+		notDue := fakeAdvertisement()
+		notDue.Status = advertisement.StatusOpened
+
+		assigned := fakeAdvertisement()
+		assigned.Status = advertisement.StatusOpened
+		assigned.ExpirationDate = time.Now().Add(-time.Hour)
+		selected := uuid.New()
+		assigned.SelectedCandidate = &selected
+
+		now := time.Now()
+		m.repo.On("ExpireDue", ctx, now, 50, mock.AnythingOfType("func(*advertisement.Model) error")).
+			Run(func(args mock.Arguments) {
+				canExpire := args.Get(3).(func(*advertisement.Model) error)
+				assert.NoError(t, canExpire(due))
+				assert.Error(t, canExpire(notDue))
+				assert.Error(t, canExpire(assigned))
+			}).
+			Return([]*advertisement.Model{due}, nil).
+			Once()
+
+		got, err := uc.Expire(ctx, now, 50)
+		assert.NoError(t, err)
+		assert.Equal(t, []*advertisement.Model{due}, got)
+	})
+}
+
+func TestAdvertisementUseCase_GetByID(t *testing.T) {
+	t.Run("Success with owner of unlisted advertisement", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleCostumer)
+
+		token := "fake-share-token"
+		ad := fakeAdvertisement()
+		ad.ID = uuid.New()
+		ad.CostumerID = usr.ID
+		ad.Visibility = advertisement.VisibilityUnlisted
+		ad.ShareToken = &token
+
+		m.repo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		m.repo.On("GetFieldValues", ctx, ad.ID).
+			Return([]*advertisement.FieldValue{}, nil).
+			Once()
+
+		got, err := uc.GetByID(ctx, ad.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, &token, got.ShareToken)
+	})
+
+	t.Run("Success with invited worker", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleWorker)
+
+		ad := fakeAdvertisement()
+		ad.ID = uuid.New()
+		ad.Visibility = advertisement.VisibilityInviteOnly
+
+		m.repo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		m.repo.On("IsParticipant", ctx, ad.ID, usr.ID).
+			Return(true, nil).
+			Once()
+
+		m.repo.On("GetFieldValues", ctx, ad.ID).
+			Return([]*advertisement.FieldValue{}, nil).
+			Once()
+
+		got, err := uc.GetByID(ctx, ad.ID)
+		assert.NoError(t, err)
+		assert.NotNil(t, got)
+	})
+
+	t.Run("Fail with anonymous user and unlisted advertisement", func(t *testing.T) {
+		_, _, m, uc := setupTest(t, user.RoleWorker)
+		ctx := context.TODO()
+
+		token := "fake-share-token"
+		ad := fakeAdvertisement()
+		ad.ID = uuid.New()
+		ad.Visibility = advertisement.VisibilityUnlisted
+		ad.ShareToken = &token
+
+		m.repo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		got, err := uc.GetByID(ctx, ad.ID)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusNotFound, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with worker not invited", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleWorker)
+
+		ad := fakeAdvertisement()
+		ad.ID = uuid.New()
+		ad.Visibility = advertisement.VisibilityInviteOnly
+
+		m.repo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		m.repo.On("IsParticipant", ctx, ad.ID, usr.ID).
+			Return(false, nil).
+			Once()
+
+		got, err := uc.GetByID(ctx, ad.ID)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusNotFound, apierrors.Parse(err).StatusCode())
+	})
+}
+
+func TestAdvertisementUseCase_GetByShareToken(t *testing.T) {
+	t.Run("Success hiding share token", func(t *testing.T) {
+		ctx, _, m, uc := setupTest(t, user.RoleWorker)
+
+		token := "fake-share-token"
+		ad := fakeAdvertisement()
+		ad.ID = uuid.New()
+		ad.Visibility = advertisement.VisibilityUnlisted
+		ad.ShareToken = &token
+
+		m.repo.On("GetByShareToken", ctx, token).
+			Return(ad, nil).
+			Once()
+
+		m.repo.On("GetFieldValues", ctx, ad.ID).
+			Return([]*advertisement.FieldValue{}, nil).
+			Once()
+
+		got, err := uc.GetByShareToken(ctx, token)
+		assert.NoError(t, err)
+		assert.Equal(t, ad.ID, got.ID)
+		assert.Nil(t, got.ShareToken)
+	})
+}
+
+func TestAdvertisementUseCase_GetAdvertisements(t *testing.T) {
+	pq := &utils.PaginationQuery{Page: 1, Size: 10}
+
+	t.Run("Success with field filters", func(t *testing.T) {
+		ctx, _, m, uc := setupTest(t, user.RoleWorker)
+
+		categoryID := uuid.New()
+		fields := fakeFields(categoryID)
+		list := &advertisement.List{Advertisements: &[]*advertisement.Model{}}
+
+		m.fieldRepo.On("GetByCategory", ctx, categoryID).
+			Return(fields, nil).
+			Once()
+
+		m.repo.On("GetAdvertisements", ctx, &advertisement.ListQuery{
+			CategoryID: &categoryID,
+			Fields: []*field.Filter{
+				{Name: "hours", Operator: field.OperatorGte, Value: "4", FieldID: fields[0].ID, Type: field.TypeInteger},
+			},
+		}, pq).
+			Return(list, nil).
+			Once()
+
+		got, err := uc.GetAdvertisements(ctx, &advertisement.ListQuery{
+			CategoryID: &categoryID,
+			Fields:     []*field.Filter{{Name: "hours", Operator: field.OperatorGte, Value: "4"}},
+		}, pq)
+		assert.NoError(t, err)
+		assert.Equal(t, list, got)
+	})
+
+	t.Run("Success with typed field values", func(t *testing.T) {
+		ctx, _, m, uc := setupTest(t, user.RoleWorker)
+
+		withValues, withoutValues := fakeAdvertisement(), fakeAdvertisement()
+		withValues.ID, withoutValues.ID = uuid.New(), uuid.New()
+		list := &advertisement.List{Advertisements: &[]*advertisement.Model{withValues, withoutValues}}
+
+		m.repo.On("GetAdvertisements", ctx, &advertisement.ListQuery{}, pq).
+			Return(list, nil).
+			Once()
+
+		m.repo.On("GetPageFieldValues", ctx, []uuid.UUID{withValues.ID, withoutValues.ID}).
+			Return([]*advertisement.FieldValue{
+				{AdvertisementID: withValues.ID, Name: "hours", Type: field.TypeInteger, Value: "4"},
+				{AdvertisementID: withValues.ID, Name: "urgent", Type: field.TypeBoolean, Value: "true"},
+			}, nil).
+			Once()
+
+		got, err := uc.GetAdvertisements(ctx, &advertisement.ListQuery{}, pq)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"hours": int64(4), "urgent": true}, (*got.Advertisements)[0].Fields)
+		assert.Empty(t, (*got.Advertisements)[1].Fields)
+	})
+
+	t.Run("Fail with field filters without category", func(t *testing.T) {
+		ctx, _, _, uc := setupTest(t, user.RoleWorker)
+
+		got, err := uc.GetAdvertisements(ctx, &advertisement.ListQuery{
+			Fields: []*field.Filter{{Name: "hours", Operator: field.OperatorGte, Value: "4"}},
+		}, pq)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusBadRequest, apierrors.Parse(err).StatusCode())
+	})
+}
+
+func TestAdvertisementUseCase_Search(t *testing.T) {
+	pq := &utils.PaginationQuery{Page: 1, Size: 10}
+
+	t.Run("Success", func(t *testing.T) {
+		ctx, _, m, uc := setupTest(t, user.RoleWorker)
+
+		query := &advertisement.SearchQuery{Text: " pintura ", Currency: "brl"}
+		list := &advertisement.SearchList{Results: &[]*advertisement.SearchResult{}}
+
+		m.repo.On("Search", ctx, &advertisement.SearchQuery{Text: "pintura", Currency: "BRL"}, pq).
+			Return(list, nil).
+			Once()
+
+		got, err := uc.Search(ctx, query, pq)
+		assert.NoError(t, err)
+		assert.Equal(t, list, got)
+	})
+
+	t.Run("Success with typed field values", func(t *testing.T) {
+		ctx, _, m, uc := setupTest(t, user.RoleWorker)
+
+		ad := fakeAdvertisement()
+		ad.ID = uuid.New()
+		list := &advertisement.SearchList{Results: &[]*advertisement.SearchResult{{Model: *ad}}}
+
+		m.repo.On("Search", ctx, &advertisement.SearchQuery{Text: "pintura"}, pq).
+			Return(list, nil).
+			Once()
+
+		m.repo.On("GetPageFieldValues", ctx, []uuid.UUID{ad.ID}).
+			Return([]*advertisement.FieldValue{{AdvertisementID: ad.ID, Name: "hours", Type: field.TypeInteger, Value: "4"}}, nil).
+			Once()
+
+		got, err := uc.Search(ctx, &advertisement.SearchQuery{Text: "pintura"}, pq)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"hours": int64(4)}, (*got.Results)[0].Fields)
+	})
+
+	t.Run("Fail with invalid filters", func(t *testing.T) {
+		ctx, _, _, uc := setupTest(t, user.RoleWorker)
+
+		minPrice, maxPrice := int64(500), int64(100)
+		queries := []*advertisement.SearchQuery{
+			{Text: "   "},
+			{Text: "pintura", Status: "archived"},
+			{Text: "pintura", MinPrice: &minPrice, MaxPrice: &maxPrice, Currency: "BRL"},
+			{Text: "pintura", MinPrice: &minPrice},
+			{Text: "pintura", MaxPrice: &maxPrice},
+			{Text: "pintura", Currency: "reais"},
+		}
+
+		for _, query := range queries {
+			got, err := uc.Search(ctx, query, pq)
+			assert.Nil(t, got)
+			assert.Equal(t, http.StatusBadRequest, apierrors.Parse(err).StatusCode())
+		}
+	})
+}
+
+func TestAdvertisementUseCase_Nearby(t *testing.T) {
+	pq := &utils.PaginationQuery{Page: 1, Size: 10}
+
+	t.Run("Success", func(t *testing.T) {
+		ctx, _, m, uc := setupTest(t, user.RoleWorker)
+
+		query := &advertisement.NearbyQuery{
+			Center:   geo.Point{Latitude: -23.55, Longitude: -46.63},
+			RadiusKm: 25,
+			Status:   advertisement.StatusOpened,
+		}
+		list := &advertisement.NearbyList{Results: &[]*advertisement.NearbyResult{}}
+
+		m.repo.On("Nearby", ctx, query, pq).Return(list, nil).Once()
+
+		got, err := uc.Nearby(ctx, query, pq)
+		assert.NoError(t, err)
+		assert.Equal(t, list, got)
+	})
+
+	t.Run("Fail with invalid query", func(t *testing.T) {
+		ctx, _, _, uc := setupTest(t, user.RoleWorker)
+
+		center := geo.Point{Latitude: -23.55, Longitude: -46.63}
+		queries := []*advertisement.NearbyQuery{
+			{Center: geo.Point{Latitude: 91, Longitude: 0}, RadiusKm: 10},
+			{Center: center, RadiusKm: 0},
+			{Center: center, RadiusKm: advertisement.MaxRadiusKm + 1},
+			{Center: center, RadiusKm: 10, Status: "archived"},
+		}
+
+		for _, query := range queries {
+			got, err := uc.Nearby(ctx, query, pq)
+			assert.Nil(t, got)
+			assert.Equal(t, http.StatusBadRequest, apierrors.Parse(err).StatusCode())
+		}
+	})
+}
+
+func fakeAdvertisement() *advertisement.Model {
+	price := int64(15000)
+
+	return &advertisement.Model{
+		CategoryID:     uuid.New(),
+		Title:          "Fake title",
+		Description:    "fake description",
+		Currency:       "brl",
+		Price:          &price,
+		ExpirationDate: time.Now().Add(24 * time.Hour),
+	}
+}
+
+type mocks struct {
+	repo         *advertisementmock.Repository
+	categoryRepo *categorymock.Repository
+	fieldRepo    *fieldmock.Repository
+}
+
+func fakeFields(categoryID uuid.UUID) []*field.Model {
+	return []*field.Model{
+		{
+			ID:         uuid.New(),
+			CategoryID: categoryID,
+			Name:       "hours",
+			Type:       field.TypeInteger,
+		},
+		{
+			ID:         uuid.New(),
+			CategoryID: categoryID,
+			Name:       "vehicle_type",
+			Type:       field.TypeSingleSelect,
+			Options: field.Options{
+				Required: true,
+				Values:   []string{"truck", "van"},
+			},
+		},
+	}
+}
+
+func setupTest(t *testing.T, role string) (context.Context, *user.Model, *mocks, advertisement.UseCase) {
+	t.Helper()
+
+	m := &mocks{
+		repo:         advertisementmock.NewRepository(t),
+		categoryRepo: categorymock.NewRepository(t),
+		fieldRepo:    fieldmock.NewRepository(t),
+	}
+	uc := usecase.NewAdvertisementUseCase(&config.Config{}, m.repo, m.categoryRepo, m.fieldRepo)
+
+	usr := &user.Model{
+		ID:    uuid.New(),
+		Email: "fake@mail.com",
+		Role:  role,
+	}
+	ctx := context.WithValue(context.TODO(), user.CtxKey{}, usr)
+
+	return ctx, usr, m, uc
+}
@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"go-api/internal/core/advertisement"
+	"go-api/internal/core/user"
+	"go-api/pkg/apierrors"
+)
+
+// actor identifies who is trying to move an advertisement between statuses.
+// A user may play more than one role on the same advertisement.
+type actor uint8
+
+const (
+	actorOwner actor = 1 << iota
+	actorSelectedWorker
+	actorAdmin
+	actorSystem
+)
+
+// transition describes who may trigger a status change and the condition
+// the advertisement must satisfy for it
+type transition struct {
+	actors actor
+	guard  func(ad *advertisement.Model) error
+}
+
+// transitions is the advertisement lifecycle. Every status other than
+// opened is final, so it has no outgoing transitions.
+var transitions = map[advertisement.Status]map[advertisement.Status]transition{
+	advertisement.StatusOpened: {
+		advertisement.StatusCanceled: {
+			actors: actorOwner | actorAdmin,
+		},
+		advertisement.StatusCompleted: {
+			actors: actorOwner | actorSelectedWorker | actorAdmin,
+			guard:  requireSelectedCandidate,
+		},
+		advertisement.StatusExpired: {
+			actors: actorSystem,
+			guard:  requireExpirable,
+		},
+	},
+}
+
+// actorsOf returns every role the user plays on the advertisement
+func actorsOf(usr *user.Model, ad *advertisement.Model) actor {
+	var a actor
+
+	if usr.HasRole(user.RoleAdmin) {
+		a |= actorAdmin
+	}
+	if usr.HasRole(user.RoleCostumer) && ad.IsOwner(usr.ID) {
+		a |= actorOwner
+	}
+	if usr.HasRole(user.RoleWorker) && ad.SelectedCandidate != nil && *ad.SelectedCandidate == usr.ID {
+		a |= actorSelectedWorker
+	}
+
+	return a
+}
+
+// checkTransition validates that the actor can move the advertisement to the given status
+func checkTransition(ad *advertisement.Model, to advertisement.Status, by actor) error {
+	if !to.IsValid() {
+		return apierrors.BadRequest("invalid status")
+	}
+
+	t, ok := transitions[ad.Status][to]
+	if !ok {
+		return invalidTransition(ad.Status, to, fmt.Sprintf("cannot move advertisement from %s to %s", ad.Status, to))
+	}
+
+	if t.actors&by == 0 {
+		return apierrors.Forbidden(fmt.Sprintf("not allowed to move advertisement to %s", to))
+	}
+
+	if t.guard != nil {
+		if err := t.guard(ad); err != nil {
+			return invalidTransition(ad.Status, to, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func requireSelectedCandidate(ad *advertisement.Model) error {
+	if ad.SelectedCandidate == nil {
+		return fmt.Errorf("cannot complete an advertisement without a selected candidate")
+	}
+
+	return nil
+}
+
+func requireExpirable(ad *advertisement.Model) error {
+	if ad.SelectedCandidate != nil {
+		return fmt.Errorf("assigned advertisements do not expire")
+	}
+	if ad.ExpirationDate.After(time.Now()) {
+		return fmt.Errorf("advertisement has not reached its expiration date")
+	}
+
+	return nil
+}
+
+func invalidTransition(from, to advertisement.Status, message string) *apierrors.APIError {
+	return apierrors.NewAPIError(http.StatusConflict, advertisement.ErrCodeInvalidTransition, message).
+		WithDetails(map[string]advertisement.Status{
+			"from": from,
+			"to":   to,
+		})
+}
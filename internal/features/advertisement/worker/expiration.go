@@ -0,0 +1,110 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go-api/internal/core/advertisement"
+	"go-api/pkg/config"
+	"go-api/pkg/logger"
+)
+
+const defaultBatchSize = 100
+
+// ExpirationWorker periodically moves opened advertisements past their
+// expiration date to expired through the advertisement use case, which
+// checks every move against the lifecycle as the system actor.
+type ExpirationWorker struct {
+	cfg       *config.Config
+	log       logger.Logger
+	uc        advertisement.UseCase
+	publisher advertisement.Publisher
+}
+
+// Expiration worker constructor
+func NewExpirationWorker(
+	cfg *config.Config,
+	log logger.Logger,
+	uc advertisement.UseCase,
+	publisher advertisement.Publisher,
+) *ExpirationWorker {
+	return &ExpirationWorker{
+		cfg:       cfg,
+		log:       log,
+		uc:        uc,
+		publisher: publisher,
+	}
+}
+
+// Start runs the worker on every configured interval until ctx is done
+func (w *ExpirationWorker) Start(ctx context.Context) {
+	interval := w.cfg.Expiration.Interval
+	if interval <= 0 {
+		w.log.Warn("Expiration worker disabled, no interval configured")
+		return
+	}
+
+	w.log.Info("Expiration worker started", logger.Fields{"interval": interval.String()})
+	defer w.log.Info("Expiration worker stopped")
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		w.run(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce expires every due advertisement in batches and returns how many
+// were expired
+func (w *ExpirationWorker) RunOnce(ctx context.Context) (int, error) {
+	batchSize := w.cfg.Expiration.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	total := 0
+	for {
+		ads, err := w.uc.Expire(ctx, time.Now(), batchSize)
+		if err != nil {
+			return total, err
+		}
+
+		total += len(ads)
+		for _, ad := range ads {
+			w.publish(ctx, ad)
+		}
+
+		if len(ads) < batchSize || ctx.Err() != nil {
+			return total, nil
+		}
+	}
+}
+
+func (w *ExpirationWorker) run(ctx context.Context) {
+	count, err := w.RunOnce(ctx)
+	if err != nil {
+		w.log.Error("Failed expiring advertisements", logger.Fields{"err": err, "expired": count})
+		return
+	}
+
+	if count > 0 {
+		w.log.Info("Expired advertisements", logger.Fields{"expired": count})
+	}
+}
+
+func (w *ExpirationWorker) publish(ctx context.Context, ad *advertisement.Model) {
+	err := w.publisher.Publish(ctx, advertisement.NewEvent(advertisement.EventExpired, ad))
+	if err != nil {
+		w.log.Error("Failed publishing advertisement expired event", logger.Fields{
+			"err":              err,
+			"advertisement_id": ad.ID,
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"go-api/internal/core/advertisement"
+	advertisementmock "go-api/internal/core/advertisement/mocks"
+	"go-api/internal/features/advertisement/worker"
+	"go-api/pkg/config"
+	loggermock "go-api/pkg/logger/mocks"
+)
+
+func TestExpirationWorker_RunOnce(t *testing.T) {
+	t.Run("Success with several batches", func(t *testing.T) {
+		ctx, m, w := setupTest(t, 2)
+
+		m.uc.On("Expire", ctx, mock.AnythingOfType("time.Time"), 2).
+			Return(fakeAdvertisements(2), nil).
+			Once()
+
+		m.uc.On("Expire", ctx, mock.AnythingOfType("time.Time"), 2).
+			Return(fakeAdvertisements(1), nil).
+			Once()
+
+		m.publisher.On("Publish", ctx, mock.MatchedBy(func(e *advertisement.Event) bool {
+			return e.Type == advertisement.EventExpired
+		})).
+			Return(nil).
+			Times(3)
+
+		count, err := w.RunOnce(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, count)
+	})
+
+	t.Run("Success with nothing to expire", func(t *testing.T) {
+		ctx, m, w := setupTest(t, 2)
+
+		m.uc.On("Expire", ctx, mock.AnythingOfType("time.Time"), 2).
+			Return([]*advertisement.Model{}, nil).
+			Once()
+
+		count, err := w.RunOnce(ctx)
+		assert.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("Success when publishing fails", func(t *testing.T) {
+		ctx, m, w := setupTest(t, 2)
+
+		m.uc.On("Expire", ctx, mock.AnythingOfType("time.Time"), 2).
+			Return(fakeAdvertisements(1), nil).
+			Once()
+
+		m.publisher.On("Publish", ctx, mock.Anything).
+			Return(errors.New("connection refused")).
+			Once()
+
+		m.log.On("Error", mock.Anything, mock.Anything).
+			Once()
+
+		count, err := w.RunOnce(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("Fail with use case error", func(t *testing.T) {
+		ctx, m, w := setupTest(t, 2)
+
+		m.uc.On("Expire", ctx, mock.AnythingOfType("time.Time"), 2).
+			Return(nil, errors.New("connection refused")).
+			Once()
+
+		_, err := w.RunOnce(ctx)
+		assert.Error(t, err)
+	})
+}
+
+func fakeAdvertisements(n int) []*advertisement.Model {
+	ads := make([]*advertisement.Model, 0, n)
+	for i := 0; i < n; i++ {
+		ads = append(ads, &advertisement.Model{
+			ID:             uuid.New(),
+			CostumerID:     uuid.New(),
+			Status:         advertisement.StatusExpired,
+			ExpirationDate: time.Now().Add(-time.Hour),
+		})
+	}
+
+	return ads
+}
+
+type mocks struct {
+	uc        *advertisementmock.UseCase
+	publisher *advertisementmock.Publisher
+	log       *loggermock.Logger
+}
+
+func setupTest(t *testing.T, batchSize int) (context.Context, *mocks, *worker.ExpirationWorker) {
+	t.Helper()
+
+	m := &mocks{
+		uc:        advertisementmock.NewUseCase(t),
+		publisher: advertisementmock.NewPublisher(t),
+		log:       loggermock.NewLogger(t),
+	}
+	cfg := &config.Config{Expiration: config.Expiration{BatchSize: batchSize}}
+	w := worker.NewExpirationWorker(cfg, m.log, m.uc, m.publisher)
+
+	return context.TODO(), m, w
+}
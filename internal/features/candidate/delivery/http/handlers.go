@@ -0,0 +1,204 @@
+package http
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"go-api/internal/core/candidate"
+	"go-api/pkg/apierrors"
+	"go-api/pkg/config"
+	"go-api/pkg/utils"
+)
+
+type candidateHandler struct {
+	cfg         *config.Config
+	candidateUC candidate.UseCase
+}
+
+func NewCandidateHandler(cfg *config.Config, candidateUC candidate.UseCase) candidate.Handlers {
+	return &candidateHandler{
+		cfg:         cfg,
+		candidateUC: candidateUC,
+	}
+}
+
+func (h *candidateHandler) Apply() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		adID, err := uuid.Parse(c.Param("advertisement_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		// The application body is optional
+		app := &candidate.Application{}
+		err = c.ShouldBindJSON(app)
+		if err != nil && !errors.Is(err, io.EOF) {
+			c.JSON(apierrors.BadRequest().JSON())
+			return
+		}
+
+		cand, err := h.candidateUC.Apply(c, adID, app)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusCreated, cand)
+	}
+}
+
+func (h *candidateHandler) Withdraw() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		adID, err := uuid.Parse(c.Param("advertisement_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		err = h.candidateUC.Withdraw(c, adID)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func (h *candidateHandler) Select() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		adID, err := uuid.Parse(c.Param("advertisement_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		workerID, err := uuid.Parse(c.Param("worker_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		ad, err := h.candidateUC.Select(c, adID, workerID)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusOK, ad)
+	}
+}
+
+func (h *candidateHandler) GetByAdvertisement() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		adID, err := uuid.Parse(c.Param("advertisement_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		pagination, err := utils.GetPaginationFromCtx(c)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		candidates, err := h.candidateUC.GetByAdvertisement(c, adID, pagination)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusOK, candidates)
+	}
+}
+
+func (h *candidateHandler) CreateOffer() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		adID, err := uuid.Parse(c.Param("advertisement_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		workerID, err := uuid.Parse(c.Param("worker_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		proposal := &candidate.Proposal{}
+		err = c.Bind(proposal)
+		if err != nil {
+			c.JSON(apierrors.BadRequest().JSON())
+			return
+		}
+
+		createdOffer, err := h.candidateUC.CreateOffer(c, adID, workerID, proposal)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusCreated, createdOffer)
+	}
+}
+
+func (h *candidateHandler) AcceptOffer() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		adID, err := uuid.Parse(c.Param("advertisement_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		workerID, err := uuid.Parse(c.Param("worker_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		offerID, err := uuid.Parse(c.Param("offer_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		offer, err := h.candidateUC.AcceptOffer(c, adID, workerID, offerID)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusOK, offer)
+	}
+}
+
+func (h *candidateHandler) GetOffers() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		adID, err := uuid.Parse(c.Param("advertisement_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		workerID, err := uuid.Parse(c.Param("worker_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		offers, err := h.candidateUC.GetOffers(c, adID, workerID)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusOK, offers)
+	}
+}
@@ -0,0 +1,20 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"go-api/internal/core/candidate"
+	"go-api/internal/core/user"
+	"go-api/internal/middleware"
+)
+
+func MapCandidateRoutes(group *gin.RouterGroup, h candidate.Handlers, mw *middleware.Manager) {
+	group.Use(mw.AuthSession())
+	group.POST("", mw.RequirePermission(user.PermCandidateApply), h.Apply())
+	group.DELETE("", mw.RequirePermission(user.PermCandidateApply), h.Withdraw())
+	group.GET("", mw.RequirePermission(user.PermCandidateRead), h.GetByAdvertisement())
+	group.POST("/:worker_id/select", mw.RequirePermission(user.PermCandidateSelect), h.Select())
+	group.GET("/:worker_id/offers", mw.RequirePermission(user.PermOfferNegotiate), h.GetOffers())
+	group.POST("/:worker_id/offers", mw.RequirePermission(user.PermOfferNegotiate), h.CreateOffer())
+	group.POST("/:worker_id/offers/:offer_id/accept", mw.RequirePermission(user.PermOfferNegotiate), h.AcceptOffer())
+}
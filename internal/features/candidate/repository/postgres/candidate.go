@@ -0,0 +1,243 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	"go-api/internal/core/advertisement"
+	"go-api/internal/core/candidate"
+	"go-api/pkg/utils"
+)
+
+type CandidateRepository struct {
+	conn *sqlx.DB
+}
+
+func NewCandidateRepository(db *sqlx.DB) candidate.Repository {
+	return &CandidateRepository{
+		conn: db,
+	}
+}
+
+// Create stores the application together with the bid opening its
+// negotiation, if any. It returns sql.ErrNoRows when the worker already
+// applied to the advertisement.
+func (r *CandidateRepository) Create(ctx context.Context, cand *candidate.Model, bid *candidate.Offer) (*candidate.Model, error) {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "CandidateRepository.Create.BeginTxx")
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	c := &candidate.Model{}
+	err = tx.QueryRowxContext(
+		ctx,
+		createCandidateQuery,
+		cand.AdvertisementID,
+		cand.WorkerID,
+	).StructScan(c)
+	if err != nil {
+		return nil, errors.Wrap(err, "CandidateRepository.Create.StructScan")
+	}
+
+	if bid != nil {
+		o := &candidate.Offer{}
+		err = tx.GetContext(
+			ctx,
+			o,
+			createOfferQuery,
+			c.AdvertisementID,
+			c.WorkerID,
+			c.WorkerID,
+			bid.Amount,
+			bid.Currency,
+			bid.Message,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "CandidateRepository.Create.GetContext")
+		}
+		c.OfferAmount = &o.Amount
+	}
+
+	return c, errors.Wrap(tx.Commit(), "CandidateRepository.Create.Commit")
+}
+
+func (r *CandidateRepository) Delete(ctx context.Context, adID, workerID uuid.UUID) error {
+	res, err := r.conn.ExecContext(ctx, deleteCandidateQuery, adID, workerID)
+	if err != nil {
+		return errors.Wrap(err, "CandidateRepository.Delete.ExecContext")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "CandidateRepository.Delete.RowsAffected")
+	}
+
+	if rowsAffected == 0 {
+		return errors.Wrap(sql.ErrNoRows, "CandidateRepository.Delete.NoRows")
+	}
+
+	return nil
+}
+
+func (r *CandidateRepository) GetByID(ctx context.Context, adID, workerID uuid.UUID) (*candidate.Model, error) {
+	c := &candidate.Model{}
+	err := r.conn.QueryRowxContext(
+		ctx,
+		getCandidateByIDQuery,
+		adID,
+		workerID,
+	).StructScan(c)
+
+	return c, errors.Wrap(err, "CandidateRepository.GetByID.StructScan")
+}
+
+// Select assigns the advertisement to the worker for the selection price,
+// accepts the selected offer and settles the status of every candidate in
+// one transaction. It returns sql.ErrNoRows when the worker did not apply or
+// already withdrew, the advertisement is no longer opened or already has a
+// selected candidate, or the offer is no longer pending.
+func (r *CandidateRepository) Select(
+	ctx context.Context,
+	adID, workerID uuid.UUID,
+	sel *candidate.Selection,
+) (*advertisement.Model, error) {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "CandidateRepository.Select.BeginTxx")
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	c := &candidate.Model{}
+	err = tx.GetContext(ctx, c, selectCandidateQuery, adID, workerID)
+	if err != nil {
+		return nil, errors.Wrap(err, "CandidateRepository.Select.selectCandidate")
+	}
+
+	ad := &advertisement.Model{}
+	err = tx.GetContext(ctx, ad, assignAdvertisementQuery, adID, workerID, sel.Price)
+	if err != nil {
+		return nil, errors.Wrap(err, "CandidateRepository.Select.assignAdvertisement")
+	}
+
+	if sel.OfferID != nil {
+		o := &candidate.Offer{}
+		err = tx.GetContext(ctx, o, acceptOfferQuery, *sel.OfferID, adID, workerID)
+		if err != nil {
+			return nil, errors.Wrap(err, "CandidateRepository.Select.acceptOffer")
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, rejectCandidatesQuery, adID, workerID)
+	if err != nil {
+		return nil, errors.Wrap(err, "CandidateRepository.Select.rejectCandidates")
+	}
+
+	return ad, errors.Wrap(tx.Commit(), "CandidateRepository.Select.Commit")
+}
+
+func (r *CandidateRepository) GetByAdvertisement(
+	ctx context.Context,
+	adID uuid.UUID,
+	pagination *utils.PaginationQuery,
+) (*candidate.List, error) {
+	var totalCount int
+	err := r.conn.GetContext(ctx, &totalCount, getCandidatesCountQuery, adID)
+	if err != nil {
+		return nil, errors.Wrap(err, "CandidateRepository.GetByAdvertisement.GetContext")
+	}
+
+	candidates := make([]*candidate.Model, 0, pagination.GetSize())
+	candidatesList := &candidate.List{
+		TotalCount: totalCount,
+		TotalPages: pagination.GetTotalPages(totalCount),
+		Page:       pagination.GetPage(),
+		Size:       pagination.GetSize(),
+		HasMore:    pagination.GetHasMore(totalCount),
+		Candidates: &candidates,
+	}
+
+	if totalCount == 0 {
+		return candidatesList, nil
+	}
+
+	err = r.conn.SelectContext(
+		ctx,
+		&candidates,
+		getCandidatesByAdvertisementQuery,
+		adID,
+		pagination.GetOffset(),
+		pagination.GetLimit(),
+		pagination.GetOrderBy(),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "CandidateRepository.GetByAdvertisement.SelectContext")
+	}
+
+	candidatesList.Candidates = &candidates
+	return candidatesList, nil
+}
+
+// CreateOffer replaces the pending offer of the thread with a new one. It
+// returns sql.ErrNoRows when a concurrent offer took its place.
+func (r *CandidateRepository) CreateOffer(ctx context.Context, offer *candidate.Offer) (*candidate.Offer, error) {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "CandidateRepository.CreateOffer.BeginTxx")
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	_, err = tx.ExecContext(ctx, counterOffersQuery, offer.AdvertisementID, offer.WorkerID)
+	if err != nil {
+		return nil, errors.Wrap(err, "CandidateRepository.CreateOffer.ExecContext")
+	}
+
+	o := &candidate.Offer{}
+	err = tx.GetContext(
+		ctx,
+		o,
+		createOfferQuery,
+		offer.AdvertisementID,
+		offer.WorkerID,
+		offer.AuthorID,
+		offer.Amount,
+		offer.Currency,
+		offer.Message,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "CandidateRepository.CreateOffer.GetContext")
+	}
+
+	return o, errors.Wrap(tx.Commit(), "CandidateRepository.CreateOffer.Commit")
+}
+
+// AcceptOffer returns sql.ErrNoRows when the offer is no longer pending or
+// belongs to another negotiation
+func (r *CandidateRepository) AcceptOffer(ctx context.Context, adID, workerID, offerID uuid.UUID) (*candidate.Offer, error) {
+	o := &candidate.Offer{}
+	err := r.conn.GetContext(ctx, o, acceptOfferQuery, offerID, adID, workerID)
+
+	return o, errors.Wrap(err, "CandidateRepository.AcceptOffer.GetContext")
+}
+
+func (r *CandidateRepository) GetOffers(ctx context.Context, adID, workerID uuid.UUID) ([]*candidate.Offer, error) {
+	offers := make([]*candidate.Offer, 0)
+	err := r.conn.SelectContext(ctx, &offers, getOffersQuery, adID, workerID)
+	if err != nil {
+		return nil, errors.Wrap(err, "CandidateRepository.GetOffers.SelectContext")
+	}
+
+	return offers, nil
+}
+
+// GetLatestOffer returns sql.ErrNoRows when there was no negotiation with the candidate
+func (r *CandidateRepository) GetLatestOffer(ctx context.Context, adID, workerID uuid.UUID) (*candidate.Offer, error) {
+	o := &candidate.Offer{}
+	err := r.conn.GetContext(ctx, o, getLatestOfferQuery, adID, workerID)
+
+	return o, errors.Wrap(err, "CandidateRepository.GetLatestOffer.GetContext")
+}
@@ -0,0 +1,470 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	"go-api/internal/core/advertisement"
+	"go-api/internal/core/candidate"
+	"go-api/internal/core/profile"
+	"go-api/internal/features/candidate/repository/postgres"
+	"go-api/pkg/utils"
+)
+
+func TestCandidateRepository_Create(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(createCandidateQuery).
+			WithArgs(want.AdvertisementID, want.WorkerID).
+			WillReturnRows(rows)
+		mock.ExpectCommit()
+
+		got, err := repo.Create(context.TODO(), want, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success with bid", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		bid := fakeOffer(want, want.WorkerID)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(createCandidateQuery).
+			WithArgs(want.AdvertisementID, want.WorkerID).
+			WillReturnRows(rows)
+		mock.ExpectQuery(createOfferQuery).
+			WithArgs(want.AdvertisementID, want.WorkerID, want.WorkerID, bid.Amount, bid.Currency, bid.Message).
+			WillReturnRows(offerRows(bid))
+		mock.ExpectCommit()
+
+		got, err := repo.Create(context.TODO(), want, bid)
+		assert.NoError(t, err)
+		assert.Equal(t, &bid.Amount, got.OfferAmount)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Fail with duplicated application", func(t *testing.T) {
+		db, repo, mock, want, _ := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(createCandidateQuery).
+			WithArgs(want.AdvertisementID, want.WorkerID).
+			WillReturnRows(sqlmock.NewRows([]string{"advertisement_id"}))
+		mock.ExpectRollback()
+
+		_, err := repo.Create(context.TODO(), want, nil)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCandidateRepository_Delete(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, _ := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectExec(deleteCandidateQuery).
+			WithArgs(want.AdvertisementID, want.WorkerID).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		err := repo.Delete(context.TODO(), want.AdvertisementID, want.WorkerID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Fail with no rows", func(t *testing.T) {
+		db, repo, mock, want, _ := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectExec(deleteCandidateQuery).
+			WithArgs(want.AdvertisementID, want.WorkerID).
+			WillReturnResult(sqlmock.NewResult(1, 0))
+
+		err := repo.Delete(context.TODO(), want.AdvertisementID, want.WorkerID)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestCandidateRepository_GetByID(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(getCandidateByIDQuery).
+			WithArgs(want.AdvertisementID, want.WorkerID).
+			WillReturnRows(rows)
+
+		got, err := repo.GetByID(context.TODO(), want.AdvertisementID, want.WorkerID)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+}
+
+func TestCandidateRepository_Select(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		price := int64(15000)
+		adRows := sqlmock.NewRows([]string{"id", "status", "selected_cadidate", "accepted_price"}).
+			AddRow(want.AdvertisementID, advertisement.StatusOpened, want.WorkerID, price)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(selectCandidateQuery).
+			WithArgs(want.AdvertisementID, want.WorkerID).
+			WillReturnRows(rows)
+		mock.ExpectQuery(assignAdvertisementQuery).
+			WithArgs(want.AdvertisementID, want.WorkerID, &price).
+			WillReturnRows(adRows)
+		mock.ExpectExec(rejectCandidatesQuery).
+			WithArgs(want.AdvertisementID, want.WorkerID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		got, err := repo.Select(context.TODO(), want.AdvertisementID, want.WorkerID, &candidate.Selection{Price: &price})
+		assert.NoError(t, err)
+		assert.Equal(t, &want.WorkerID, got.SelectedCandidate)
+		assert.Equal(t, &price, got.AcceptedPrice)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success accepting bid", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		bid := fakeOffer(want, want.WorkerID)
+		accepted := *bid
+		accepted.Status = candidate.OfferAccepted
+
+		adRows := sqlmock.NewRows([]string{"id", "status", "selected_cadidate", "accepted_price"}).
+			AddRow(want.AdvertisementID, advertisement.StatusOpened, want.WorkerID, bid.Amount)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(selectCandidateQuery).
+			WithArgs(want.AdvertisementID, want.WorkerID).
+			WillReturnRows(rows)
+		mock.ExpectQuery(assignAdvertisementQuery).
+			WithArgs(want.AdvertisementID, want.WorkerID, &bid.Amount).
+			WillReturnRows(adRows)
+		mock.ExpectQuery(acceptOfferQuery).
+			WithArgs(bid.ID, want.AdvertisementID, want.WorkerID).
+			WillReturnRows(offerRows(&accepted))
+		mock.ExpectExec(rejectCandidatesQuery).
+			WithArgs(want.AdvertisementID, want.WorkerID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		got, err := repo.Select(context.TODO(), want.AdvertisementID, want.WorkerID, &candidate.Selection{
+			Price:   &bid.Amount,
+			OfferID: &bid.ID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &bid.Amount, got.AcceptedPrice)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Fail with bid no longer pending", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		bid := fakeOffer(want, want.WorkerID)
+		adRows := sqlmock.NewRows([]string{"id", "status", "selected_cadidate", "accepted_price"}).
+			AddRow(want.AdvertisementID, advertisement.StatusOpened, want.WorkerID, bid.Amount)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(selectCandidateQuery).
+			WithArgs(want.AdvertisementID, want.WorkerID).
+			WillReturnRows(rows)
+		mock.ExpectQuery(assignAdvertisementQuery).
+			WithArgs(want.AdvertisementID, want.WorkerID, &bid.Amount).
+			WillReturnRows(adRows)
+		mock.ExpectQuery(acceptOfferQuery).
+			WithArgs(bid.ID, want.AdvertisementID, want.WorkerID).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectRollback()
+
+		_, err := repo.Select(context.TODO(), want.AdvertisementID, want.WorkerID, &candidate.Selection{
+			Price:   &bid.Amount,
+			OfferID: &bid.ID,
+		})
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Fail with candidate withdrawn", func(t *testing.T) {
+		db, repo, mock, want, _ := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(selectCandidateQuery).
+			WithArgs(want.AdvertisementID, want.WorkerID).
+			WillReturnRows(sqlmock.NewRows([]string{"advertisement_id"}))
+		mock.ExpectRollback()
+
+		_, err := repo.Select(context.TODO(), want.AdvertisementID, want.WorkerID, &candidate.Selection{})
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Fail with advertisement not assignable", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(selectCandidateQuery).
+			WithArgs(want.AdvertisementID, want.WorkerID).
+			WillReturnRows(rows)
+		mock.ExpectQuery(assignAdvertisementQuery).
+			WithArgs(want.AdvertisementID, want.WorkerID, nil).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectRollback()
+
+		_, err := repo.Select(context.TODO(), want.AdvertisementID, want.WorkerID, &candidate.Selection{})
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCandidateRepository_GetByAdvertisement(t *testing.T) {
+	pag := &utils.PaginationQuery{
+		Page: 1,
+		Size: 10,
+	}
+
+	t.Run("Success with candidates", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		totalRows := sqlmock.NewRows([]string{"count"}).AddRow(1)
+		mock.ExpectQuery(getCandidatesCountQuery).
+			WithArgs(want.AdvertisementID).
+			WillReturnRows(totalRows)
+		mock.ExpectQuery(getCandidatesByAdvertisementQuery).
+			WithArgs(want.AdvertisementID, pag.GetOffset(), pag.GetLimit(), pag.GetOrderBy()).
+			WillReturnRows(rows)
+
+		got, err := repo.GetByAdvertisement(context.TODO(), want.AdvertisementID, pag)
+		assert.NoError(t, err)
+		assert.Equal(t, []*candidate.Model{want}, *got.Candidates)
+	})
+
+	t.Run("Success with worker profile and offer", func(t *testing.T) {
+		db, repo, mock, want, _ := setupTest(t)
+		defer db.Close()
+
+		amount := int64(12000)
+		want.OfferAmount = &amount
+		want.Profile = &profile.Public{
+			UserID:      want.WorkerID,
+			DisplayName: "Maria Pinturas",
+			Skills:      []string{"pintura", "textura"},
+			Categories:  []uuid.UUID{uuid.New()},
+		}
+		doc, err := json.Marshal(want.Profile)
+		assert.NoError(t, err)
+
+		rows := sqlmock.NewRows([]string{
+			"advertisement_id", "worker_id", "status", "offer_amount", "profile", "created_at", "updated_at",
+		}).AddRow(want.AdvertisementID, want.WorkerID, want.Status, amount, doc, want.CreatedAt, want.UpdatedAt)
+
+		totalRows := sqlmock.NewRows([]string{"count"}).AddRow(1)
+		mock.ExpectQuery(getCandidatesCountQuery).
+			WithArgs(want.AdvertisementID).
+			WillReturnRows(totalRows)
+		mock.ExpectQuery(getCandidatesByAdvertisementQuery).
+			WithArgs(want.AdvertisementID, pag.GetOffset(), pag.GetLimit(), pag.GetOrderBy()).
+			WillReturnRows(rows)
+
+		got, err := repo.GetByAdvertisement(context.TODO(), want.AdvertisementID, pag)
+		assert.NoError(t, err)
+		assert.Equal(t, []*candidate.Model{want}, *got.Candidates)
+	})
+}
+
+func TestCandidateRepository_CreateOffer(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, _ := setupTest(t)
+		defer db.Close()
+
+		offer := fakeOffer(want, uuid.New())
+
+		mock.ExpectBegin()
+		mock.ExpectExec(counterOffersQuery).
+			WithArgs(want.AdvertisementID, want.WorkerID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(createOfferQuery).
+			WithArgs(want.AdvertisementID, want.WorkerID, offer.AuthorID, offer.Amount, offer.Currency, offer.Message).
+			WillReturnRows(offerRows(offer))
+		mock.ExpectCommit()
+
+		got, err := repo.CreateOffer(context.TODO(), offer)
+		assert.NoError(t, err)
+		assert.Equal(t, offer, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Fail with concurrent offer", func(t *testing.T) {
+		db, repo, mock, want, _ := setupTest(t)
+		defer db.Close()
+
+		offer := fakeOffer(want, uuid.New())
+
+		mock.ExpectBegin()
+		mock.ExpectExec(counterOffersQuery).
+			WithArgs(want.AdvertisementID, want.WorkerID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(createOfferQuery).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectRollback()
+
+		_, err := repo.CreateOffer(context.TODO(), offer)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCandidateRepository_AcceptOffer(t *testing.T) {
+	db, repo, mock, want, _ := setupTest(t)
+	defer db.Close()
+
+	offer := fakeOffer(want, want.WorkerID)
+	offer.Status = candidate.OfferAccepted
+
+	mock.ExpectQuery(acceptOfferQuery).
+		WithArgs(offer.ID, offer.AdvertisementID, offer.WorkerID).
+		WillReturnRows(offerRows(offer))
+
+	got, err := repo.AcceptOffer(context.TODO(), offer.AdvertisementID, offer.WorkerID, offer.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, offer, got)
+}
+
+func TestCandidateRepository_GetOffers(t *testing.T) {
+	db, repo, mock, want, _ := setupTest(t)
+	defer db.Close()
+
+	offer := fakeOffer(want, want.WorkerID)
+
+	mock.ExpectQuery(getOffersQuery).
+		WithArgs(want.AdvertisementID, want.WorkerID).
+		WillReturnRows(offerRows(offer))
+
+	got, err := repo.GetOffers(context.TODO(), want.AdvertisementID, want.WorkerID)
+	assert.NoError(t, err)
+	assert.Equal(t, []*candidate.Offer{offer}, got)
+}
+
+func TestCandidateRepository_GetLatestOffer(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, _ := setupTest(t)
+		defer db.Close()
+
+		offer := fakeOffer(want, want.WorkerID)
+
+		mock.ExpectQuery(getLatestOfferQuery).
+			WithArgs(want.AdvertisementID, want.WorkerID).
+			WillReturnRows(offerRows(offer))
+
+		got, err := repo.GetLatestOffer(context.TODO(), want.AdvertisementID, want.WorkerID)
+		assert.NoError(t, err)
+		assert.Equal(t, offer, got)
+	})
+
+	t.Run("Fail without offers", func(t *testing.T) {
+		db, repo, mock, want, _ := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(getLatestOfferQuery).
+			WithArgs(want.AdvertisementID, want.WorkerID).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		_, err := repo.GetLatestOffer(context.TODO(), want.AdvertisementID, want.WorkerID)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func fakeOffer(cand *candidate.Model, authorID uuid.UUID) *candidate.Offer {
+	return &candidate.Offer{
+		ID:              uuid.New(),
+		AdvertisementID: cand.AdvertisementID,
+		WorkerID:        cand.WorkerID,
+		AuthorID:        authorID,
+		Amount:          12000,
+		Currency:        "BRL",
+		Message:         "Consigo fazer em dois dias",
+		Status:          candidate.OfferPending,
+		CreatedAt:       time.Now().UTC(),
+		UpdatedAt:       time.Now().UTC(),
+	}
+}
+
+func offerRows(o *candidate.Offer) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"id",
+		"advertisement_id",
+		"worker_id",
+		"author_id",
+		"amount",
+		"currency",
+		"message",
+		"status",
+		"created_at",
+		"updated_at",
+	}).AddRow(
+		o.ID,
+		o.AdvertisementID,
+		o.WorkerID,
+		o.AuthorID,
+		o.Amount,
+		o.Currency,
+		o.Message,
+		o.Status,
+		o.CreatedAt,
+		o.UpdatedAt,
+	)
+}
+
+func setupTest(t *testing.T) (*sql.DB, candidate.Repository, sqlmock.Sqlmock, *candidate.Model, *sqlmock.Rows) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	dbx := sqlx.NewDb(db, "sqlmock")
+	repo := postgres.NewCandidateRepository(dbx)
+
+	want := &candidate.Model{
+		AdvertisementID: uuid.New(),
+		WorkerID:        uuid.New(),
+		Status:          candidate.StatusApplied,
+		CreatedAt:       time.Now().UTC(),
+		UpdatedAt:       time.Now().UTC(),
+	}
+
+	rows := sqlmock.NewRows([]string{
+		"advertisement_id",
+		"worker_id",
+		"status",
+		"created_at",
+		"updated_at",
+	}).AddRow(
+		want.AdvertisementID,
+		want.WorkerID,
+		want.Status,
+		want.CreatedAt,
+		want.UpdatedAt,
+	)
+
+	return db, repo, mock, want, rows
+}
@@ -0,0 +1,108 @@
+package postgres
+
+const (
+	createCandidateQuery = `
+		INSERT INTO candidates (advertisement_id, worker_id)
+		VALUES ($1, $2)
+		ON CONFLICT (advertisement_id, worker_id) DO NOTHING
+		RETURNING advertisement_id, worker_id, status, created_at, updated_at
+	`
+
+	deleteCandidateQuery = `DELETE FROM candidates WHERE advertisement_id = $1 AND worker_id = $2 AND status <> 'selected'`
+
+	getCandidateByIDQuery = `
+		SELECT advertisement_id, worker_id, status, created_at, updated_at
+		FROM candidates
+		WHERE advertisement_id = $1 AND worker_id = $2
+	`
+
+	// The candidate row is selected first, so it stays locked until the
+	// advertisement is assigned and a concurrent withdraw cannot delete it
+	selectCandidateQuery = `
+		UPDATE candidates
+		SET status = 'selected',
+			updated_at = CURRENT_TIMESTAMP
+		WHERE advertisement_id = $1 AND worker_id = $2 AND status = 'applied'
+		RETURNING advertisement_id, worker_id, status, created_at, updated_at
+	`
+
+	assignAdvertisementQuery = `
+		UPDATE advertisements
+		SET selected_cadidate = $2,
+			accepted_price = $3,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+			AND status = 'opened'
+			AND selected_cadidate IS NULL
+		RETURNING id, costumer_id, category_id, title, description, status, currency, price, expiration_date,
+			selected_cadidate, location, visibility, share_token, accepted_price, created_at, updated_at
+	`
+
+	rejectCandidatesQuery = `
+		UPDATE candidates
+		SET status = 'rejected',
+			updated_at = CURRENT_TIMESTAMP
+		WHERE advertisement_id = $1 AND worker_id <> $2
+	`
+
+	getCandidatesCountQuery = `SELECT COUNT(worker_id) FROM candidates WHERE advertisement_id = $1`
+
+	getCandidatesByAdvertisementQuery = `
+		SELECT c.advertisement_id, c.worker_id, c.status, c.created_at, c.updated_at,
+			(
+				SELECT o.amount
+				FROM offers o
+				WHERE o.advertisement_id = c.advertisement_id AND o.worker_id = c.worker_id
+				ORDER BY o.created_at DESC
+				LIMIT 1
+			) AS offer_amount,
+			worker_profile_public(c.worker_id) AS profile
+		FROM candidates c
+		LEFT JOIN user_reputations r ON r.user_id = c.worker_id
+		WHERE c.advertisement_id = $1
+		ORDER BY
+			CASE WHEN $4::TEXT = 'reputation'
+				THEN reputation_score(COALESCE(r.rating_sum, 0), COALESCE(r.review_count, 0))
+			END DESC,
+			c.created_at
+		OFFSET $2
+		LIMIT $3
+	`
+
+	counterOffersQuery = `
+		UPDATE offers
+		SET status = 'countered',
+			updated_at = CURRENT_TIMESTAMP
+		WHERE advertisement_id = $1 AND worker_id = $2 AND status = 'pending'
+	`
+
+	createOfferQuery = `
+		INSERT INTO offers (advertisement_id, worker_id, author_id, amount, currency, message)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (advertisement_id, worker_id) WHERE status = 'pending' DO NOTHING
+		RETURNING id, advertisement_id, worker_id, author_id, amount, currency, message, status, created_at, updated_at
+	`
+
+	acceptOfferQuery = `
+		UPDATE offers
+		SET status = 'accepted',
+			updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND advertisement_id = $2 AND worker_id = $3 AND status = 'pending'
+		RETURNING id, advertisement_id, worker_id, author_id, amount, currency, message, status, created_at, updated_at
+	`
+
+	getOffersQuery = `
+		SELECT id, advertisement_id, worker_id, author_id, amount, currency, message, status, created_at, updated_at
+		FROM offers
+		WHERE advertisement_id = $1 AND worker_id = $2
+		ORDER BY created_at
+	`
+
+	getLatestOfferQuery = `
+		SELECT id, advertisement_id, worker_id, author_id, amount, currency, message, status, created_at, updated_at
+		FROM offers
+		WHERE advertisement_id = $1 AND worker_id = $2
+		ORDER BY created_at DESC
+		LIMIT 1
+	`
+)
@@ -0,0 +1,202 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"go-api/internal/core/advertisement"
+	"go-api/internal/core/candidate"
+	"go-api/internal/core/user"
+	"go-api/pkg/apierrors"
+	"go-api/pkg/config"
+	"go-api/pkg/logger"
+	"go-api/pkg/utils"
+)
+
+type candidateUseCase struct {
+	cfg       *config.Config
+	log       logger.Logger
+	repo      candidate.Repository
+	adRepo    advertisement.Repository
+	publisher advertisement.Publisher
+}
+
+func NewCandidateUseCase(
+	cfg *config.Config,
+	log logger.Logger,
+	repo candidate.Repository,
+	adRepo advertisement.Repository,
+	publisher advertisement.Publisher,
+) candidate.UseCase {
+	return &candidateUseCase{
+		cfg:       cfg,
+		log:       log,
+		repo:      repo,
+		adRepo:    adRepo,
+		publisher: publisher,
+	}
+}
+
+// Apply adds the worker as a candidate. Invite only advertisements only take
+// invited workers and unlisted ones require the share token.
+func (uc *candidateUseCase) Apply(ctx context.Context, adID uuid.UUID, app *candidate.Application) (*candidate.Model, error) {
+	usr, err := user.RequireRole(ctx, user.RoleWorker)
+	if err != nil {
+		return nil, err
+	}
+
+	ad, err := uc.adRepo.GetByID(ctx, adID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = advertisement.CheckVisible(ctx, uc.adRepo, ad, app.ShareToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if ad.Status != advertisement.StatusOpened {
+		return nil, apierrors.Conflict("advertisement is not opened")
+	}
+
+	if !ad.ExpirationDate.After(time.Now()) {
+		return nil, apierrors.Conflict("advertisement has expired")
+	}
+
+	if ad.SelectedCandidate != nil {
+		return nil, apierrors.Conflict("advertisement already has a selected candidate")
+	}
+
+	var bid *candidate.Offer
+	if app.Bid != nil {
+		bid, err = app.Bid.Offer(ad.Currency)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cand, err := uc.repo.Create(ctx, &candidate.Model{
+		AdvertisementID: ad.ID,
+		WorkerID:        usr.ID,
+	}, bid)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apierrors.Conflict("already applied to this advertisement")
+	}
+
+	return cand, err
+}
+
+func (uc *candidateUseCase) Withdraw(ctx context.Context, adID uuid.UUID) error {
+	usr, err := user.RequireRole(ctx, user.RoleWorker)
+	if err != nil {
+		return err
+	}
+
+	cand, err := uc.repo.GetByID(ctx, adID, usr.ID)
+	if err != nil {
+		return err
+	}
+
+	if cand.Status == candidate.StatusSelected {
+		return apierrors.Conflict("selected candidates cannot withdraw")
+	}
+
+	// The candidate may have been selected since it was read, so the delete
+	// only affects a row that is still not selected
+	err = uc.repo.Delete(ctx, adID, usr.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return apierrors.Conflict("selected candidates cannot withdraw")
+	}
+
+	return err
+}
+
+func (uc *candidateUseCase) Select(ctx context.Context, adID, workerID uuid.UUID) (*advertisement.Model, error) {
+	usr, err := user.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ad, err := uc.adRepo.GetByID(ctx, adID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ad.IsOwner(usr.ID) {
+		return nil, apierrors.Forbidden("only the advertisement owner can select a candidate")
+	}
+
+	if ad.SelectedCandidate != nil {
+		return nil, apierrors.Conflict("advertisement already has a selected candidate")
+	}
+
+	if ad.Status != advertisement.StatusOpened {
+		return nil, apierrors.Conflict("advertisement is not opened")
+	}
+
+	_, err = uc.repo.GetByID(ctx, adID, workerID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apierrors.NotFound("worker did not apply to this advertisement")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sel, err := uc.selection(ctx, ad, workerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The repository checks the same conditions again under the transaction,
+	// so a concurrent selection, withdrawal or offer ends up here
+	selectedAd, err := uc.repo.Select(ctx, adID, workerID, sel)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apierrors.Conflict("advertisement can no longer be assigned to this worker")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.publisher.Publish(ctx, advertisement.NewEvent(advertisement.EventAssigned, selectedAd))
+	if err != nil {
+		uc.log.Error("Failed publishing advertisement assigned event", logger.Fields{
+			"err":              err,
+			"advertisement_id": selectedAd.ID,
+			"worker_id":        workerID,
+		})
+	}
+
+	return selectedAd, nil
+}
+
+func (uc *candidateUseCase) GetByAdvertisement(
+	ctx context.Context,
+	adID uuid.UUID,
+	pq *utils.PaginationQuery,
+) (*candidate.List, error) {
+	usr, err := user.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch pq.GetOrderBy() {
+	case "", candidate.OrderByCreatedAt, candidate.OrderByReputation:
+	default:
+		return nil, apierrors.BadRequest("invalid order_by")
+	}
+
+	ad, err := uc.adRepo.GetByID(ctx, adID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !usr.CanActOn(ad.CostumerID) {
+		return nil, apierrors.Forbidden()
+	}
+
+	return uc.repo.GetByAdvertisement(ctx, adID, pq)
+}
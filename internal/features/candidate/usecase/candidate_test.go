@@ -0,0 +1,808 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"go-api/internal/core/advertisement"
+	advertisementmock "go-api/internal/core/advertisement/mocks"
+	"go-api/internal/core/candidate"
+	candidatemock "go-api/internal/core/candidate/mocks"
+	"go-api/internal/core/user"
+	"go-api/internal/features/candidate/usecase"
+	"go-api/pkg/apierrors"
+	"go-api/pkg/config"
+	loggermock "go-api/pkg/logger/mocks"
+	"go-api/pkg/money"
+	"go-api/pkg/utils"
+)
+
+func TestCandidateUseCase_Apply(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleWorker)
+
+		ad := fakeAdvertisement()
+		cand := &candidate.Model{AdvertisementID: ad.ID, WorkerID: usr.ID}
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		m.repo.On("Create", ctx, cand, (*candidate.Offer)(nil)).
+			Return(cand, nil).
+			Once()
+
+		got, err := uc.Apply(ctx, ad.ID, &candidate.Application{})
+		assert.NoError(t, err)
+		assert.Equal(t, cand, got)
+	})
+
+	t.Run("Success with bid", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleWorker)
+
+		ad := fakeAdvertisement()
+		amount := int64(12000)
+		bid := &candidate.Offer{Amount: amount, Currency: "BRL", Message: "Consigo fazer em dois dias"}
+		cand := &candidate.Model{AdvertisementID: ad.ID, WorkerID: usr.ID}
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		m.repo.On("Create", ctx, cand, bid).
+			Return(&candidate.Model{AdvertisementID: ad.ID, WorkerID: usr.ID, OfferAmount: &amount}, nil).
+			Once()
+
+		got, err := uc.Apply(ctx, ad.ID, &candidate.Application{
+			Bid: &candidate.Proposal{
+				Price:   &money.Money{Amount: amount, Currency: "BRL"},
+				Message: " Consigo fazer em dois dias ",
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &amount, got.OfferAmount)
+	})
+
+	t.Run("Fail with bid in another currency", func(t *testing.T) {
+		ctx, _, m, uc := setupTest(t, user.RoleWorker)
+
+		ad := fakeAdvertisement()
+		amount := int64(12000)
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		got, err := uc.Apply(ctx, ad.ID, &candidate.Application{
+			Bid: &candidate.Proposal{Price: &money.Money{Amount: amount, Currency: "USD"}},
+		})
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusBadRequest, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with duplicated application", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleWorker)
+
+		ad := fakeAdvertisement()
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		m.repo.On("Create", ctx, &candidate.Model{AdvertisementID: ad.ID, WorkerID: usr.ID}, (*candidate.Offer)(nil)).
+			Return(nil, sql.ErrNoRows).
+			Once()
+
+		got, err := uc.Apply(ctx, ad.ID, &candidate.Application{})
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusConflict, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with closed advertisement", func(t *testing.T) {
+		ctx, _, m, uc := setupTest(t, user.RoleWorker)
+
+		ad := fakeAdvertisement()
+		ad.Status = advertisement.StatusCanceled
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		got, err := uc.Apply(ctx, ad.ID, &candidate.Application{})
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusConflict, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with expired advertisement", func(t *testing.T) {
+		ctx, _, m, uc := setupTest(t, user.RoleWorker)
+
+		ad := fakeAdvertisement()
+		ad.ExpirationDate = time.Now().Add(-time.Minute)
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		got, err := uc.Apply(ctx, ad.ID, &candidate.Application{})
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusConflict, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Success with share token of unlisted advertisement", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleWorker)
+
+		token := "fake-share-token"
+		ad := fakeAdvertisement()
+		ad.Visibility = advertisement.VisibilityUnlisted
+		ad.ShareToken = &token
+		cand := &candidate.Model{AdvertisementID: ad.ID, WorkerID: usr.ID}
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		m.repo.On("Create", ctx, cand, (*candidate.Offer)(nil)).
+			Return(cand, nil).
+			Once()
+
+		got, err := uc.Apply(ctx, ad.ID, &candidate.Application{ShareToken: token})
+		assert.NoError(t, err)
+		assert.Equal(t, cand, got)
+	})
+
+	t.Run("Fail with wrong share token of unlisted advertisement", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleWorker)
+
+		token := "fake-share-token"
+		ad := fakeAdvertisement()
+		ad.Visibility = advertisement.VisibilityUnlisted
+		ad.ShareToken = &token
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		m.adRepo.On("IsParticipant", ctx, ad.ID, usr.ID).
+			Return(false, nil).
+			Once()
+
+		got, err := uc.Apply(ctx, ad.ID, &candidate.Application{ShareToken: "wrong"})
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusNotFound, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Success with invitation to invite only advertisement", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleWorker)
+
+		ad := fakeAdvertisement()
+		ad.Visibility = advertisement.VisibilityInviteOnly
+		cand := &candidate.Model{AdvertisementID: ad.ID, WorkerID: usr.ID}
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		m.adRepo.On("IsParticipant", ctx, ad.ID, usr.ID).
+			Return(true, nil).
+			Once()
+
+		m.repo.On("Create", ctx, cand, (*candidate.Offer)(nil)).
+			Return(cand, nil).
+			Once()
+
+		got, err := uc.Apply(ctx, ad.ID, &candidate.Application{})
+		assert.NoError(t, err)
+		assert.Equal(t, cand, got)
+	})
+
+	t.Run("Fail without invitation to invite only advertisement", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleWorker)
+
+		ad := fakeAdvertisement()
+		ad.Visibility = advertisement.VisibilityInviteOnly
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		m.adRepo.On("IsParticipant", ctx, ad.ID, usr.ID).
+			Return(false, nil).
+			Once()
+
+		got, err := uc.Apply(ctx, ad.ID, &candidate.Application{})
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusNotFound, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with costumer", func(t *testing.T) {
+		ctx, _, _, uc := setupTest(t, user.RoleCostumer)
+
+		got, err := uc.Apply(ctx, uuid.New(), &candidate.Application{})
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusForbidden, apierrors.Parse(err).StatusCode())
+	})
+}
+
+func TestCandidateUseCase_Withdraw(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleWorker)
+
+		adID := uuid.New()
+
+		m.repo.On("GetByID", ctx, adID, usr.ID).
+			Return(&candidate.Model{AdvertisementID: adID, WorkerID: usr.ID, Status: candidate.StatusApplied}, nil).
+			Once()
+
+		m.repo.On("Delete", ctx, adID, usr.ID).
+			Return(nil).
+			Once()
+
+		err := uc.Withdraw(ctx, adID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Fail with selected candidate", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleWorker)
+
+		adID := uuid.New()
+
+		m.repo.On("GetByID", ctx, adID, usr.ID).
+			Return(&candidate.Model{AdvertisementID: adID, WorkerID: usr.ID, Status: candidate.StatusSelected}, nil).
+			Once()
+
+		err := uc.Withdraw(ctx, adID)
+		assert.Equal(t, http.StatusConflict, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with candidate selected concurrently", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleWorker)
+
+		adID := uuid.New()
+
+		m.repo.On("GetByID", ctx, adID, usr.ID).
+			Return(&candidate.Model{AdvertisementID: adID, WorkerID: usr.ID, Status: candidate.StatusApplied}, nil).
+			Once()
+
+		m.repo.On("Delete", ctx, adID, usr.ID).
+			Return(sql.ErrNoRows).
+			Once()
+
+		err := uc.Withdraw(ctx, adID)
+		assert.Equal(t, http.StatusConflict, apierrors.Parse(err).StatusCode())
+	})
+}
+
+func TestCandidateUseCase_Select(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleCostumer)
+
+		ad := fakeAdvertisement()
+		ad.CostumerID = usr.ID
+		workerID := uuid.New()
+
+		selected := *ad
+		selected.SelectedCandidate = &workerID
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		m.repo.On("GetByID", ctx, ad.ID, workerID).
+			Return(&candidate.Model{AdvertisementID: ad.ID, WorkerID: workerID}, nil).
+			Once()
+
+		m.repo.On("GetLatestOffer", ctx, ad.ID, workerID).
+			Return(nil, sql.ErrNoRows).
+			Once()
+
+		m.repo.On("Select", ctx, ad.ID, workerID, &candidate.Selection{Price: ad.Price}).
+			Return(&selected, nil).
+			Once()
+
+		m.publisher.On("Publish", ctx, mock.MatchedBy(func(e *advertisement.Event) bool {
+			return e.Type == advertisement.EventAssigned && *e.WorkerID == workerID
+		})).
+			Return(nil).
+			Once()
+
+		got, err := uc.Select(ctx, ad.ID, workerID)
+		assert.NoError(t, err)
+		assert.Equal(t, &workerID, got.SelectedCandidate)
+	})
+
+	t.Run("Success accepting bid of the worker", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleCostumer)
+
+		ad := fakeAdvertisement()
+		ad.CostumerID = usr.ID
+		workerID := uuid.New()
+		bid := &candidate.Offer{
+			ID:       uuid.New(),
+			WorkerID: workerID,
+			AuthorID: workerID,
+			Amount:   12000,
+			Status:   candidate.OfferPending,
+		}
+
+		selected := *ad
+		selected.SelectedCandidate = &workerID
+		selected.AcceptedPrice = &bid.Amount
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		m.repo.On("GetByID", ctx, ad.ID, workerID).
+			Return(&candidate.Model{AdvertisementID: ad.ID, WorkerID: workerID}, nil).
+			Once()
+
+		m.repo.On("GetLatestOffer", ctx, ad.ID, workerID).
+			Return(bid, nil).
+			Once()
+
+		m.repo.On("Select", ctx, ad.ID, workerID, &candidate.Selection{Price: &bid.Amount, OfferID: &bid.ID}).
+			Return(&selected, nil).
+			Once()
+
+		m.publisher.On("Publish", ctx, mock.Anything).
+			Return(nil).
+			Once()
+
+		got, err := uc.Select(ctx, ad.ID, workerID)
+		assert.NoError(t, err)
+		assert.Equal(t, &bid.Amount, got.AcceptedPrice)
+	})
+
+	t.Run("Fail with counter-offer not accepted", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleCostumer)
+
+		ad := fakeAdvertisement()
+		ad.CostumerID = usr.ID
+		workerID := uuid.New()
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		m.repo.On("GetByID", ctx, ad.ID, workerID).
+			Return(&candidate.Model{AdvertisementID: ad.ID, WorkerID: workerID}, nil).
+			Once()
+
+		m.repo.On("GetLatestOffer", ctx, ad.ID, workerID).
+			Return(&candidate.Offer{AuthorID: usr.ID, Amount: 10000, Status: candidate.OfferPending}, nil).
+			Once()
+
+		got, err := uc.Select(ctx, ad.ID, workerID)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusConflict, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Success when publishing fails", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleCostumer)
+
+		ad := fakeAdvertisement()
+		ad.CostumerID = usr.ID
+		workerID := uuid.New()
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		m.repo.On("GetByID", ctx, ad.ID, workerID).
+			Return(&candidate.Model{AdvertisementID: ad.ID, WorkerID: workerID}, nil).
+			Once()
+
+		m.repo.On("GetLatestOffer", ctx, ad.ID, workerID).
+			Return(nil, sql.ErrNoRows).
+			Once()
+
+		m.repo.On("Select", ctx, ad.ID, workerID, &candidate.Selection{Price: ad.Price}).
+			Return(ad, nil).
+			Once()
+
+		m.publisher.On("Publish", ctx, mock.Anything).
+			Return(errors.New("connection refused")).
+			Once()
+
+		m.log.On("Error", mock.Anything, mock.Anything).
+			Once()
+
+		got, err := uc.Select(ctx, ad.ID, workerID)
+		assert.NoError(t, err)
+		assert.NotNil(t, got)
+	})
+
+	t.Run("Fail with another owner", func(t *testing.T) {
+		ctx, _, m, uc := setupTest(t, user.RoleCostumer)
+
+		ad := fakeAdvertisement()
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		got, err := uc.Select(ctx, ad.ID, uuid.New())
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusForbidden, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with already selected", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleCostumer)
+
+		ad := fakeAdvertisement()
+		ad.CostumerID = usr.ID
+		selectedID := uuid.New()
+		ad.SelectedCandidate = &selectedID
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		got, err := uc.Select(ctx, ad.ID, uuid.New())
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusConflict, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with worker that did not apply", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleCostumer)
+
+		ad := fakeAdvertisement()
+		ad.CostumerID = usr.ID
+		workerID := uuid.New()
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		m.repo.On("GetByID", ctx, ad.ID, workerID).
+			Return(nil, sql.ErrNoRows).
+			Once()
+
+		got, err := uc.Select(ctx, ad.ID, workerID)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusNotFound, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with concurrent selection", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleCostumer)
+
+		ad := fakeAdvertisement()
+		ad.CostumerID = usr.ID
+		workerID := uuid.New()
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		m.repo.On("GetByID", ctx, ad.ID, workerID).
+			Return(&candidate.Model{AdvertisementID: ad.ID, WorkerID: workerID}, nil).
+			Once()
+
+		m.repo.On("GetLatestOffer", ctx, ad.ID, workerID).
+			Return(nil, sql.ErrNoRows).
+			Once()
+
+		m.repo.On("Select", ctx, ad.ID, workerID, &candidate.Selection{Price: ad.Price}).
+			Return(nil, sql.ErrNoRows).
+			Once()
+
+		got, err := uc.Select(ctx, ad.ID, workerID)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusConflict, apierrors.Parse(err).StatusCode())
+	})
+}
+
+func TestCandidateUseCase_GetByAdvertisement(t *testing.T) {
+	pq := &utils.PaginationQuery{Page: 1, Size: 10}
+
+	t.Run("Success", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleCostumer)
+
+		ad := fakeAdvertisement()
+		ad.CostumerID = usr.ID
+		list := &candidate.List{Candidates: &[]*candidate.Model{}}
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		m.repo.On("GetByAdvertisement", ctx, ad.ID, pq).
+			Return(list, nil).
+			Once()
+
+		got, err := uc.GetByAdvertisement(ctx, ad.ID, pq)
+		assert.NoError(t, err)
+		assert.Equal(t, list, got)
+	})
+
+	t.Run("Fail with another owner", func(t *testing.T) {
+		ctx, _, m, uc := setupTest(t, user.RoleCostumer)
+
+		ad := fakeAdvertisement()
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		got, err := uc.GetByAdvertisement(ctx, ad.ID, pq)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusForbidden, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with unknown order", func(t *testing.T) {
+		ctx, _, _, uc := setupTest(t, user.RoleCostumer)
+
+		got, err := uc.GetByAdvertisement(ctx, uuid.New(), &utils.PaginationQuery{Page: 1, Size: 10, OrderBy: "email"})
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusBadRequest, apierrors.Parse(err).StatusCode())
+	})
+}
+
+func TestCandidateUseCase_CreateOffer(t *testing.T) {
+	t.Run("Success with counter-offer of the owner", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleCostumer)
+
+		ad := fakeAdvertisement()
+		ad.CostumerID = usr.ID
+		workerID := uuid.New()
+		want := &candidate.Offer{
+			AdvertisementID: ad.ID,
+			WorkerID:        workerID,
+			AuthorID:        usr.ID,
+			Amount:          13000,
+			Currency:        "BRL",
+			Message:         "Fecho por 130",
+		}
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		m.repo.On("GetByID", ctx, ad.ID, workerID).
+			Return(&candidate.Model{AdvertisementID: ad.ID, WorkerID: workerID}, nil).
+			Once()
+
+		m.repo.On("CreateOffer", ctx, want).
+			Return(want, nil).
+			Once()
+
+		got, err := uc.CreateOffer(ctx, ad.ID, workerID, &candidate.Proposal{
+			Price:   &money.Money{Amount: 13000, Currency: "BRL"},
+			Message: "Fecho por 130",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("Fail with another worker", func(t *testing.T) {
+		ctx, _, m, uc := setupTest(t, user.RoleWorker)
+
+		ad := fakeAdvertisement()
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		got, err := uc.CreateOffer(ctx, ad.ID, uuid.New(), &candidate.Proposal{
+			Price: &money.Money{Amount: 13000, Currency: "BRL"},
+		})
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusForbidden, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with assigned advertisement", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleWorker)
+
+		ad := fakeAdvertisement()
+		ad.SelectedCandidate = &usr.ID
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		m.repo.On("GetByID", ctx, ad.ID, usr.ID).
+			Return(&candidate.Model{AdvertisementID: ad.ID, WorkerID: usr.ID}, nil).
+			Once()
+
+		got, err := uc.CreateOffer(ctx, ad.ID, usr.ID, &candidate.Proposal{
+			Price: &money.Money{Amount: 13000, Currency: "BRL"},
+		})
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusConflict, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with another currency", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleWorker)
+
+		ad := fakeAdvertisement()
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		m.repo.On("GetByID", ctx, ad.ID, usr.ID).
+			Return(&candidate.Model{AdvertisementID: ad.ID, WorkerID: usr.ID}, nil).
+			Once()
+
+		got, err := uc.CreateOffer(ctx, ad.ID, usr.ID, &candidate.Proposal{
+			Price: &money.Money{Amount: 13000, Currency: "EUR"},
+		})
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusBadRequest, apierrors.Parse(err).StatusCode())
+	})
+}
+
+func TestCandidateUseCase_AcceptOffer(t *testing.T) {
+	t.Run("Success accepting counter-offer as worker", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleWorker)
+
+		ad := fakeAdvertisement()
+		offer := &candidate.Offer{
+			ID:       uuid.New(),
+			WorkerID: usr.ID,
+			AuthorID: ad.CostumerID,
+			Amount:   13000,
+			Status:   candidate.OfferPending,
+		}
+		accepted := *offer
+		accepted.Status = candidate.OfferAccepted
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		m.repo.On("GetByID", ctx, ad.ID, usr.ID).
+			Return(&candidate.Model{AdvertisementID: ad.ID, WorkerID: usr.ID}, nil).
+			Once()
+
+		m.repo.On("GetLatestOffer", ctx, ad.ID, usr.ID).
+			Return(offer, nil).
+			Once()
+
+		m.repo.On("AcceptOffer", ctx, ad.ID, usr.ID, offer.ID).
+			Return(&accepted, nil).
+			Once()
+
+		got, err := uc.AcceptOffer(ctx, ad.ID, usr.ID, offer.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, candidate.OfferAccepted, got.Status)
+	})
+
+	t.Run("Fail accepting own offer", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleWorker)
+
+		ad := fakeAdvertisement()
+		offer := &candidate.Offer{ID: uuid.New(), AuthorID: usr.ID, Status: candidate.OfferPending}
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		m.repo.On("GetByID", ctx, ad.ID, usr.ID).
+			Return(&candidate.Model{AdvertisementID: ad.ID, WorkerID: usr.ID}, nil).
+			Once()
+
+		m.repo.On("GetLatestOffer", ctx, ad.ID, usr.ID).
+			Return(offer, nil).
+			Once()
+
+		got, err := uc.AcceptOffer(ctx, ad.ID, usr.ID, offer.ID)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusForbidden, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with countered offer", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleWorker)
+
+		ad := fakeAdvertisement()
+		latest := &candidate.Offer{ID: uuid.New(), AuthorID: ad.CostumerID, Status: candidate.OfferPending}
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		m.repo.On("GetByID", ctx, ad.ID, usr.ID).
+			Return(&candidate.Model{AdvertisementID: ad.ID, WorkerID: usr.ID}, nil).
+			Once()
+
+		m.repo.On("GetLatestOffer", ctx, ad.ID, usr.ID).
+			Return(latest, nil).
+			Once()
+
+		got, err := uc.AcceptOffer(ctx, ad.ID, usr.ID, uuid.New())
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusConflict, apierrors.Parse(err).StatusCode())
+	})
+}
+
+func TestCandidateUseCase_GetOffers(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleCostumer)
+
+		ad := fakeAdvertisement()
+		ad.CostumerID = usr.ID
+		workerID := uuid.New()
+		offers := []*candidate.Offer{{ID: uuid.New(), AuthorID: workerID, Amount: 12000}}
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		m.repo.On("GetByID", ctx, ad.ID, workerID).
+			Return(&candidate.Model{AdvertisementID: ad.ID, WorkerID: workerID}, nil).
+			Once()
+
+		m.repo.On("GetOffers", ctx, ad.ID, workerID).
+			Return(offers, nil).
+			Once()
+
+		got, err := uc.GetOffers(ctx, ad.ID, workerID)
+		assert.NoError(t, err)
+		assert.Equal(t, offers, got)
+	})
+
+	t.Run("Fail without application", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleWorker)
+
+		ad := fakeAdvertisement()
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		m.repo.On("GetByID", ctx, ad.ID, usr.ID).
+			Return(nil, sql.ErrNoRows).
+			Once()
+
+		got, err := uc.GetOffers(ctx, ad.ID, usr.ID)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusNotFound, apierrors.Parse(err).StatusCode())
+	})
+}
+
+func fakeAdvertisement() *advertisement.Model {
+	return &advertisement.Model{
+		ID:             uuid.New(),
+		CostumerID:     uuid.New(),
+		Status:         advertisement.StatusOpened,
+		Visibility:     advertisement.VisibilityPublic,
+		Currency:       "BRL",
+		ExpirationDate: time.Now().Add(24 * time.Hour),
+	}
+}
+
+type mocks struct {
+	repo      *candidatemock.Repository
+	adRepo    *advertisementmock.Repository
+	publisher *advertisementmock.Publisher
+	log       *loggermock.Logger
+}
+
+func setupTest(t *testing.T, role string) (context.Context, *user.Model, *mocks, candidate.UseCase) {
+	t.Helper()
+
+	m := &mocks{
+		repo:      candidatemock.NewRepository(t),
+		adRepo:    advertisementmock.NewRepository(t),
+		publisher: advertisementmock.NewPublisher(t),
+		log:       loggermock.NewLogger(t),
+	}
+	uc := usecase.NewCandidateUseCase(&config.Config{}, m.log, m.repo, m.adRepo, m.publisher)
+
+	usr := &user.Model{
+		ID:    uuid.New(),
+		Email: "fake@mail.com",
+		Role:  role,
+	}
+	ctx := context.WithValue(context.TODO(), user.CtxKey{}, usr)
+
+	return ctx, usr, m, uc
+}
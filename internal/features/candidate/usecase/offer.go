@@ -0,0 +1,165 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"go-api/internal/core/advertisement"
+	"go-api/internal/core/candidate"
+	"go-api/internal/core/user"
+	"go-api/pkg/apierrors"
+)
+
+// CreateOffer sends a counter-offer to the other side of the negotiation
+// between the advertisement owner and the candidate
+func (uc *candidateUseCase) CreateOffer(
+	ctx context.Context,
+	adID, workerID uuid.UUID,
+	proposal *candidate.Proposal,
+) (*candidate.Offer, error) {
+	usr, ad, err := uc.getNegotiation(ctx, adID, workerID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkNegotiable(ad)
+	if err != nil {
+		return nil, err
+	}
+
+	offer, err := proposal.Offer(ad.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	offer.AdvertisementID = ad.ID
+	offer.WorkerID = workerID
+	offer.AuthorID = usr.ID
+
+	created, err := uc.repo.CreateOffer(ctx, offer)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apierrors.Conflict("another offer was sent, try again")
+	}
+
+	return created, err
+}
+
+// AcceptOffer accepts the pending offer sent by the other side of the
+// negotiation. The accepted amount is the one the advertisement is assigned
+// for when the candidate is selected.
+func (uc *candidateUseCase) AcceptOffer(ctx context.Context, adID, workerID, offerID uuid.UUID) (*candidate.Offer, error) {
+	usr, ad, err := uc.getNegotiation(ctx, adID, workerID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkNegotiable(ad)
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := uc.repo.GetLatestOffer(ctx, adID, workerID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apierrors.NotFound("offer not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if latest.ID != offerID || latest.Status != candidate.OfferPending {
+		return nil, apierrors.Conflict("only the pending offer can be accepted")
+	}
+
+	if latest.AuthorID == usr.ID {
+		return nil, apierrors.Forbidden("cannot accept your own offer")
+	}
+
+	accepted, err := uc.repo.AcceptOffer(ctx, adID, workerID, offerID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apierrors.Conflict("offer is no longer pending")
+	}
+
+	return accepted, err
+}
+
+func (uc *candidateUseCase) GetOffers(ctx context.Context, adID, workerID uuid.UUID) ([]*candidate.Offer, error) {
+	_, _, err := uc.getNegotiation(ctx, adID, workerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.GetOffers(ctx, adID, workerID)
+}
+
+// getNegotiation checks the user is the advertisement owner or the candidate
+// of the negotiation
+func (uc *candidateUseCase) getNegotiation(
+	ctx context.Context,
+	adID, workerID uuid.UUID,
+) (*user.Model, *advertisement.Model, error) {
+	usr, err := user.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ad, err := uc.adRepo.GetByID(ctx, adID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !ad.IsOwner(usr.ID) && usr.ID != workerID {
+		return nil, nil, apierrors.Forbidden("only the advertisement owner and the candidate can negotiate")
+	}
+
+	_, err = uc.repo.GetByID(ctx, adID, workerID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, apierrors.NotFound("worker did not apply to this advertisement")
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return usr, ad, nil
+}
+
+// selection returns the price the advertisement is assigned for when the
+// candidate is selected. Selecting a worker accepts their pending bid, while a
+// counter-offer of the costumer must be accepted by the worker first.
+// Without negotiation the advertisement price is kept.
+func (uc *candidateUseCase) selection(
+	ctx context.Context,
+	ad *advertisement.Model,
+	workerID uuid.UUID,
+) (*candidate.Selection, error) {
+	latest, err := uc.repo.GetLatestOffer(ctx, ad.ID, workerID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &candidate.Selection{Price: ad.Price}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case latest.Status == candidate.OfferAccepted:
+		return &candidate.Selection{Price: &latest.Amount}, nil
+	case latest.AuthorID == workerID:
+		return &candidate.Selection{Price: &latest.Amount, OfferID: &latest.ID}, nil
+	default:
+		return nil, apierrors.Conflict("candidate has not accepted the last counter-offer")
+	}
+}
+
+func checkNegotiable(ad *advertisement.Model) error {
+	if ad.Status != advertisement.StatusOpened {
+		return apierrors.Conflict("advertisement is not opened")
+	}
+
+	if ad.SelectedCandidate != nil {
+		return apierrors.Conflict("advertisement already has a selected candidate")
+	}
+
+	return nil
+}
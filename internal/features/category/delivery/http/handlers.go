@@ -0,0 +1,124 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"go-api/internal/core/category"
+	"go-api/pkg/apierrors"
+	"go-api/pkg/config"
+	"go-api/pkg/utils"
+)
+
+type categoryHandler struct {
+	cfg        *config.Config
+	categoryUC category.UseCase
+}
+
+func NewCategoryHandler(cfg *config.Config, categoryUC category.UseCase) category.Handlers {
+	return &categoryHandler{
+		cfg:        cfg,
+		categoryUC: categoryUC,
+	}
+}
+
+func (h *categoryHandler) Create() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		cat := &category.Model{}
+		err := c.Bind(cat)
+		if err != nil {
+			c.JSON(apierrors.BadRequest().JSON())
+			return
+		}
+
+		createdCategory, err := h.categoryUC.Create(c, cat)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusCreated, createdCategory)
+	}
+}
+
+func (h *categoryHandler) Update() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := uuid.Parse(c.Param("category_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		cat := &category.Model{}
+		err = c.Bind(cat)
+		if err != nil {
+			c.JSON(apierrors.BadRequest().JSON())
+			return
+		}
+		cat.ID = id
+
+		updatedCategory, err := h.categoryUC.Update(c, cat)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusOK, updatedCategory)
+	}
+}
+
+func (h *categoryHandler) Delete() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := uuid.Parse(c.Param("category_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		err = h.categoryUC.Delete(c, id)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func (h *categoryHandler) GetByID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := uuid.Parse(c.Param("category_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		cat, err := h.categoryUC.GetByID(c, id)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusOK, cat)
+	}
+}
+
+func (h *categoryHandler) GetCategories() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pagination, err := utils.GetPaginationFromCtx(c)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		categories, err := h.categoryUC.GetCategories(c, pagination)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusOK, categories)
+	}
+}
@@ -0,0 +1,115 @@
+package http_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-api/internal/core/category"
+	categorymock "go-api/internal/core/category/mocks"
+	categoryhttp "go-api/internal/features/category/delivery/http"
+	"go-api/pkg/config"
+	"go-api/pkg/utils"
+)
+
+func TestCategoryHandler_Create(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		var (
+			ctx, rw, categoryUC, h = setupTest(t)
+			cat                    = &category.Model{
+				Name:        "Fake Category",
+				Description: "fake description",
+			}
+			created = &category.Model{
+				ID:          uuid.New(),
+				Name:        cat.Name,
+				Description: cat.Description,
+			}
+		)
+
+		setupRequest(t, ctx, http.MethodPost, cat)
+
+		categoryUC.On("Create", ctx, cat).
+			Return(created, nil).
+			Once()
+
+		handlerFunc := h.Create()
+		handlerFunc(ctx)
+
+		assert.Equal(t, http.StatusCreated, rw.Result().StatusCode)
+		plainCategory, err := json.Marshal(created)
+		assert.NoError(t, err)
+		assert.Equal(t, string(plainCategory), rw.Body.String())
+	})
+}
+
+func TestCategoryHandler_GetCategories(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctx, rw, categoryUC, h := setupTest(t)
+		ctx.Request.URL = &url.URL{RawQuery: "size=10&page=1"}
+
+		list := &category.List{Categories: &[]*category.Model{}}
+		categoryUC.On("GetCategories", ctx, &utils.PaginationQuery{Size: 10, Page: 1}).
+			Return(list, nil).
+			Once()
+
+		handlerFunc := h.GetCategories()
+		handlerFunc(ctx)
+
+		assert.Equal(t, http.StatusOK, rw.Result().StatusCode)
+	})
+
+	t.Run("Fail with invalid size", func(t *testing.T) {
+		ctx, rw, _, h := setupTest(t)
+		ctx.Request.URL = &url.URL{RawQuery: "size=0&page=1"}
+
+		handlerFunc := h.GetCategories()
+		handlerFunc(ctx)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Result().StatusCode)
+	})
+}
+
+func setupTest(t *testing.T) (*gin.Context, *httptest.ResponseRecorder, *categorymock.UseCase, category.Handlers) {
+	t.Helper()
+
+	categoryUC := categorymock.NewUseCase(t)
+	categoryHandlers := categoryhttp.NewCategoryHandler(&config.Config{}, categoryUC)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = &http.Request{
+		Header: make(http.Header),
+	}
+
+	return ctx, w, categoryUC, categoryHandlers
+}
+
+func setupRequest(t *testing.T, ctx *gin.Context, method string, v any) {
+	t.Helper()
+
+	ctx.Request = &http.Request{
+		Method: method,
+		Header: make(http.Header),
+	}
+
+	switch method {
+	case http.MethodPost, http.MethodPut:
+		ctx.Request.Header.Set("Content-Type", "application/json")
+	}
+
+	jsonValue, err := json.Marshal(v)
+	require.NoError(t, err)
+
+	ctx.Request.Body = io.NopCloser(bytes.NewBuffer(jsonValue))
+}
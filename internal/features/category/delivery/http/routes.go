@@ -0,0 +1,20 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"go-api/internal/core/category"
+	"go-api/internal/core/user"
+	"go-api/internal/middleware"
+)
+
+func MapCategoryRoutes(group *gin.RouterGroup, h category.Handlers, mw *middleware.Manager) {
+	group.GET("", h.GetCategories())
+	group.GET("/:category_id", h.GetByID())
+
+	group.Use(mw.AuthSession())
+	group.Use(mw.RequirePermission(user.PermCategoryManage))
+	group.POST("", h.Create())
+	group.PUT("/:category_id", h.Update())
+	group.DELETE("/:category_id", h.Delete())
+}
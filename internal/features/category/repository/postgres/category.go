@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	"go-api/internal/core/category"
+	"go-api/pkg/utils"
+)
+
+type CategoryRepository struct {
+	conn *sqlx.DB
+}
+
+func NewCategoryRepository(db *sqlx.DB) category.Repository {
+	return &CategoryRepository{
+		conn: db,
+	}
+}
+
+func (r *CategoryRepository) Create(ctx context.Context, cat *category.Model) (*category.Model, error) {
+	c := &category.Model{}
+	err := r.conn.QueryRowxContext(
+		ctx,
+		createCategoryQuery,
+		cat.Name,
+		cat.Description,
+	).StructScan(c)
+
+	return c, errors.Wrap(err, "CategoryRepository.Create.StructScan")
+}
+
+func (r *CategoryRepository) Update(ctx context.Context, cat *category.Model) (*category.Model, error) {
+	c := &category.Model{}
+	err := r.conn.GetContext(
+		ctx,
+		c,
+		updateCategoryQuery,
+		cat.ID,
+		cat.Name,
+		cat.Description,
+	)
+
+	return c, errors.Wrap(err, "CategoryRepository.Update.GetContext")
+}
+
+func (r *CategoryRepository) Delete(ctx context.Context, categoryID uuid.UUID) error {
+	res, err := r.conn.ExecContext(ctx, deleteCategoryQuery, categoryID)
+	if err != nil {
+		return errors.Wrap(err, "CategoryRepository.Delete.ExecContext")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "CategoryRepository.Delete.RowsAffected")
+	}
+
+	if rowsAffected == 0 {
+		return errors.Wrap(sql.ErrNoRows, "CategoryRepository.Delete.NoRows")
+	}
+
+	return nil
+}
+
+func (r *CategoryRepository) GetByID(ctx context.Context, categoryID uuid.UUID) (*category.Model, error) {
+	c := &category.Model{}
+	err := r.conn.QueryRowxContext(
+		ctx,
+		getCategoryByIDQuery,
+		categoryID,
+	).StructScan(c)
+
+	return c, errors.Wrap(err, "CategoryRepository.GetByID.StructScan")
+}
+
+func (r *CategoryRepository) GetCategories(ctx context.Context, pagination *utils.PaginationQuery) (*category.List, error) {
+	var totalCount int
+	err := r.conn.GetContext(ctx, &totalCount, getCategoriesCountQuery)
+	if err != nil {
+		return nil, errors.Wrap(err, "CategoryRepository.GetCategories.GetContext")
+	}
+
+	categories := make([]*category.Model, 0, pagination.GetSize())
+	categoriesList := &category.List{
+		TotalCount: totalCount,
+		TotalPages: pagination.GetTotalPages(totalCount),
+		Page:       pagination.GetPage(),
+		Size:       pagination.GetSize(),
+		HasMore:    pagination.GetHasMore(totalCount),
+		Categories: &categories,
+	}
+
+	if totalCount == 0 {
+		return categoriesList, nil
+	}
+
+	err = r.conn.SelectContext(
+		ctx,
+		&categories,
+		getAllCategoriesQuery,
+		pagination.GetOrderBy(),
+		pagination.GetOffset(),
+		pagination.GetLimit(),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "CategoryRepository.GetCategories.SelectContext")
+	}
+
+	categoriesList.Categories = &categories
+	return categoriesList, nil
+}
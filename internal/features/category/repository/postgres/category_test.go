@@ -0,0 +1,154 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	"go-api/internal/core/category"
+	"go-api/internal/features/category/repository/postgres"
+	"go-api/pkg/utils"
+)
+
+func TestCategoryRepository_Create(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(createCategoryQuery).
+			WithArgs(want.Name, want.Description).
+			WillReturnRows(rows)
+
+		got, err := repo.Create(context.TODO(), want)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+}
+
+func TestCategoryRepository_Update(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(updateCategoryQuery).
+			WithArgs(want.ID, want.Name, want.Description).
+			WillReturnRows(rows)
+
+		got, err := repo.Update(context.TODO(), want)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+}
+
+func TestCategoryRepository_Delete(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, _ := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectExec(deleteCategoryQuery).
+			WithArgs(want.ID).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		err := repo.Delete(context.TODO(), want.ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Fail with no rows", func(t *testing.T) {
+		db, repo, mock, want, _ := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectExec(deleteCategoryQuery).
+			WithArgs(want.ID).
+			WillReturnResult(sqlmock.NewResult(1, 0))
+
+		err := repo.Delete(context.TODO(), want.ID)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestCategoryRepository_GetByID(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(getCategoryByIDQuery).
+			WithArgs(want.ID).
+			WillReturnRows(rows)
+
+		got, err := repo.GetByID(context.TODO(), want.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+}
+
+func TestCategoryRepository_GetCategories(t *testing.T) {
+	pag := &utils.PaginationQuery{
+		OrderBy: "",
+		Page:    0,
+		Size:    10,
+	}
+
+	t.Run("Success with categories", func(t *testing.T) {
+		db, repo, mock, _, rows := setupTest(t)
+		defer db.Close()
+
+		totalRows := sqlmock.NewRows([]string{"count"}).AddRow(1)
+		mock.ExpectQuery(getCategoriesCountQuery).WillReturnRows(totalRows)
+		mock.ExpectQuery(getAllCategoriesQuery).
+			WithArgs(pag.OrderBy, pag.Page, pag.Size).
+			WillReturnRows(rows)
+
+		got, err := repo.GetCategories(context.TODO(), pag)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(*got.Categories))
+	})
+
+	t.Run("Success with no categories", func(t *testing.T) {
+		db, repo, mock, _, _ := setupTest(t)
+		defer db.Close()
+
+		totalRows := sqlmock.NewRows([]string{"count"}).AddRow(0)
+		mock.ExpectQuery(getCategoriesCountQuery).WillReturnRows(totalRows)
+
+		got, err := repo.GetCategories(context.TODO(), pag)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(*got.Categories))
+	})
+}
+
+func setupTest(t *testing.T) (*sql.DB, category.Repository, sqlmock.Sqlmock, *category.Model, *sqlmock.Rows) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	dbx := sqlx.NewDb(db, "sqlmock")
+	repo := postgres.NewCategoryRepository(dbx)
+
+	want := &category.Model{
+		ID:          uuid.New(),
+		Name:        "Fake Category",
+		Description: "fake description",
+		CreatedAt:   time.Now().UTC(),
+		UpdatedAt:   time.Now().UTC(),
+	}
+
+	rows := sqlmock.NewRows([]string{
+		"id",
+		"name",
+		"description",
+		"created_at",
+		"updated_at",
+	}).AddRow(
+		want.ID,
+		want.Name,
+		want.Description,
+		want.CreatedAt,
+		want.UpdatedAt,
+	)
+
+	return db, repo, mock, want, rows
+}
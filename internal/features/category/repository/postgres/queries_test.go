@@ -0,0 +1,36 @@
+package postgres_test
+
+const (
+	createCategoryQuery = `
+		INSERT INTO categories \(name, description\)
+		VALUES \(\$1, \$2\)
+		RETURNING id, name, COALESCE\(description, ''\) AS description, created_at, updated_at
+	`
+
+	updateCategoryQuery = `
+		UPDATE categories
+		SET name = COALESCE\(NULLIF\(\$2, ''\), name\),
+			description = COALESCE\(NULLIF\(\$3, ''\), description\),
+			updated_at = CURRENT_TIMESTAMP
+		WHERE id = \$1
+		RETURNING id, name, COALESCE\(description, ''\) AS description, created_at, updated_at
+	`
+
+	deleteCategoryQuery = `DELETE FROM categories WHERE id = \$1`
+
+	getCategoryByIDQuery = `
+		SELECT id, name, COALESCE\(description, ''\) AS description, created_at, updated_at
+		FROM categories
+		WHERE id = \$1
+	`
+
+	getCategoriesCountQuery = `SELECT COUNT\(id\) FROM categories`
+
+	getAllCategoriesQuery = `
+		SELECT id, name, COALESCE\(description, ''\) AS description, created_at, updated_at
+		FROM categories
+		ORDER BY COALESCE\(NULLIF\(\$1, ''\), name\)
+		OFFSET \$2
+		LIMIT \$3
+	`
+)
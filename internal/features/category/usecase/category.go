@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"go-api/internal/core/category"
+	"go-api/internal/core/user"
+	"go-api/pkg/apierrors"
+	"go-api/pkg/config"
+	"go-api/pkg/utils"
+)
+
+const maxNameLength = 64
+
+type categoryUseCase struct {
+	cfg  *config.Config
+	repo category.Repository
+}
+
+func NewCategoryUseCase(cfg *config.Config, repo category.Repository) category.UseCase {
+	return &categoryUseCase{cfg: cfg, repo: repo}
+}
+
+func (uc *categoryUseCase) Create(ctx context.Context, cat *category.Model) (*category.Model, error) {
+	_, err := user.RequireRole(ctx, user.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	cat.Name = strings.TrimSpace(cat.Name)
+	if cat.Name == "" {
+		return nil, apierrors.BadRequest("name is required")
+	}
+
+	if len(cat.Name) > maxNameLength {
+		return nil, apierrors.BadRequest("name is too long")
+	}
+
+	return uc.repo.Create(ctx, cat)
+}
+
+func (uc *categoryUseCase) Update(ctx context.Context, cat *category.Model) (*category.Model, error) {
+	_, err := user.RequireRole(ctx, user.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	cat.Name = strings.TrimSpace(cat.Name)
+	if len(cat.Name) > maxNameLength {
+		return nil, apierrors.BadRequest("name is too long")
+	}
+
+	return uc.repo.Update(ctx, cat)
+}
+
+func (uc *categoryUseCase) Delete(ctx context.Context, categoryID uuid.UUID) error {
+	_, err := user.RequireRole(ctx, user.RoleAdmin)
+	if err != nil {
+		return err
+	}
+
+	return uc.repo.Delete(ctx, categoryID)
+}
+
+func (uc *categoryUseCase) GetByID(ctx context.Context, categoryID uuid.UUID) (*category.Model, error) {
+	return uc.repo.GetByID(ctx, categoryID)
+}
+
+func (uc *categoryUseCase) GetCategories(ctx context.Context, pq *utils.PaginationQuery) (*category.List, error) {
+	return uc.repo.GetCategories(ctx, pq)
+}
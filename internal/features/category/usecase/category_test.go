@@ -0,0 +1,156 @@
+package usecase_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"go-api/internal/core/category"
+	categorymock "go-api/internal/core/category/mocks"
+	"go-api/internal/core/user"
+	"go-api/internal/features/category/usecase"
+	"go-api/pkg/apierrors"
+	"go-api/pkg/config"
+	"go-api/pkg/utils"
+)
+
+func TestCategoryUseCase_Create(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctx, mock, uc := setupTest(t, user.RoleAdmin)
+
+		cat := &category.Model{
+			Name:        "Fake Category",
+			Description: "fake description",
+		}
+
+		mock.On("Create", ctx, cat).
+			Return(cat, nil).
+			Once()
+
+		got, err := uc.Create(ctx, cat)
+		assert.NoError(t, err)
+		assert.Equal(t, cat, got)
+	})
+
+	t.Run("Fail with no name", func(t *testing.T) {
+		ctx, _, uc := setupTest(t, user.RoleAdmin)
+
+		got, err := uc.Create(ctx, &category.Model{Name: " "})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusBadRequest, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with no admin", func(t *testing.T) {
+		ctx, _, uc := setupTest(t, user.RoleCostumer)
+
+		got, err := uc.Create(ctx, &category.Model{Name: "Fake Category"})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusForbidden, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with no user", func(t *testing.T) {
+		mock := categorymock.NewRepository(t)
+		uc := usecase.NewCategoryUseCase(&config.Config{}, mock)
+
+		got, err := uc.Create(context.TODO(), &category.Model{Name: "Fake Category"})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusUnauthorized, apierrors.Parse(err).StatusCode())
+	})
+}
+
+func TestCategoryUseCase_Update(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctx, mock, uc := setupTest(t, user.RoleAdmin)
+
+		cat := &category.Model{
+			ID:   uuid.New(),
+			Name: "Renamed Category",
+		}
+
+		mock.On("Update", ctx, cat).
+			Return(cat, nil).
+			Once()
+
+		got, err := uc.Update(ctx, cat)
+		assert.NoError(t, err)
+		assert.Equal(t, cat, got)
+	})
+
+	t.Run("Fail with no admin", func(t *testing.T) {
+		ctx, _, uc := setupTest(t, user.RoleWorker)
+
+		got, err := uc.Update(ctx, &category.Model{ID: uuid.New()})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+}
+
+func TestCategoryUseCase_Delete(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctx, mock, uc := setupTest(t, user.RoleAdmin)
+
+		id := uuid.New()
+
+		mock.On("Delete", ctx, id).
+			Return(nil).
+			Once()
+
+		err := uc.Delete(ctx, id)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Fail with no admin", func(t *testing.T) {
+		ctx, _, uc := setupTest(t, user.RoleCostumer)
+
+		err := uc.Delete(ctx, uuid.New())
+		assert.Error(t, err)
+	})
+}
+
+func TestCategoryUseCase_GetCategories(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mock := categorymock.NewRepository(t)
+		uc := usecase.NewCategoryUseCase(&config.Config{}, mock)
+		ctx := context.TODO()
+
+		pq := &utils.PaginationQuery{
+			OrderBy: "",
+			Page:    0,
+			Size:    10,
+		}
+
+		list := &category.List{
+			Categories: &[]*category.Model{{ID: uuid.New(), Name: "Fake Category"}},
+		}
+
+		mock.On("GetCategories", ctx, pq).
+			Return(list, nil).
+			Once()
+
+		got, err := uc.GetCategories(ctx, pq)
+		assert.NoError(t, err)
+		assert.Equal(t, list, got)
+	})
+}
+
+func setupTest(t *testing.T, role string) (context.Context, *categorymock.Repository, category.UseCase) {
+	t.Helper()
+
+	repo := categorymock.NewRepository(t)
+	uc := usecase.NewCategoryUseCase(&config.Config{}, repo)
+
+	usr := &user.Model{
+		ID:    uuid.New(),
+		Email: "fake@mail.com",
+		Role:  role,
+	}
+	ctx := context.WithValue(context.TODO(), user.CtxKey{}, usr)
+
+	return ctx, repo, uc
+}
@@ -0,0 +1,161 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"go-api/internal/core/field"
+	"go-api/pkg/apierrors"
+	"go-api/pkg/config"
+)
+
+type fieldHandler struct {
+	cfg     *config.Config
+	fieldUC field.UseCase
+}
+
+func NewFieldHandler(cfg *config.Config, fieldUC field.UseCase) field.Handlers {
+	return &fieldHandler{
+		cfg:     cfg,
+		fieldUC: fieldUC,
+	}
+}
+
+func (h *fieldHandler) Create() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		categoryID, err := uuid.Parse(c.Param("category_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		fld := &field.Model{}
+		err = c.Bind(fld)
+		if err != nil {
+			c.JSON(bindError(err).JSON())
+			return
+		}
+		fld.CategoryID = categoryID
+
+		createdField, err := h.fieldUC.Create(c, fld)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusCreated, createdField)
+	}
+}
+
+func (h *fieldHandler) Update() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		categoryID, err := uuid.Parse(c.Param("category_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		fieldID, err := uuid.Parse(c.Param("field_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		fld := &field.Model{}
+		err = c.Bind(fld)
+		if err != nil {
+			c.JSON(bindError(err).JSON())
+			return
+		}
+		fld.ID = fieldID
+		fld.CategoryID = categoryID
+
+		updatedField, err := h.fieldUC.Update(c, fld)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusOK, updatedField)
+	}
+}
+
+func (h *fieldHandler) Delete() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		categoryID, err := uuid.Parse(c.Param("category_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		fieldID, err := uuid.Parse(c.Param("field_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		err = h.fieldUC.Delete(c, categoryID, fieldID)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func (h *fieldHandler) GetByID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		categoryID, err := uuid.Parse(c.Param("category_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		fieldID, err := uuid.Parse(c.Param("field_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		fld, err := h.fieldUC.GetByID(c, categoryID, fieldID)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusOK, fld)
+	}
+}
+
+func (h *fieldHandler) GetByCategory() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		categoryID, err := uuid.Parse(c.Param("category_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		fields, err := h.fieldUC.GetByCategory(c, categoryID)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusOK, fields)
+	}
+}
+
+// bindError keeps the invalid options error of a field body and hides any
+// other decoding error behind a plain bad request
+func bindError(err error) *apierrors.APIError {
+	var apiErr *apierrors.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+
+	return apierrors.BadRequest()
+}
@@ -0,0 +1,20 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"go-api/internal/core/field"
+	"go-api/internal/core/user"
+	"go-api/internal/middleware"
+)
+
+func MapFieldRoutes(group *gin.RouterGroup, h field.Handlers, mw *middleware.Manager) {
+	group.GET("", h.GetByCategory())
+	group.GET("/:field_id", h.GetByID())
+
+	group.Use(mw.AuthSession())
+	group.Use(mw.RequirePermission(user.PermCategoryManage))
+	group.POST("", h.Create())
+	group.PUT("/:field_id", h.Update())
+	group.DELETE("/:field_id", h.Delete())
+}
@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	"go-api/internal/core/field"
+)
+
+type FieldRepository struct {
+	conn *sqlx.DB
+}
+
+func NewFieldRepository(db *sqlx.DB) field.Repository {
+	return &FieldRepository{
+		conn: db,
+	}
+}
+
+func (r *FieldRepository) Create(ctx context.Context, fld *field.Model) (*field.Model, error) {
+	f := &field.Model{}
+	err := r.conn.QueryRowxContext(
+		ctx,
+		createFieldQuery,
+		fld.CategoryID,
+		fld.Name,
+		fld.Description,
+		fld.Type,
+		fld.Options,
+	).StructScan(f)
+
+	return f, errors.Wrap(err, "FieldRepository.Create.StructScan")
+}
+
+func (r *FieldRepository) Update(ctx context.Context, fld *field.Model) (*field.Model, error) {
+	f := &field.Model{}
+	err := r.conn.GetContext(
+		ctx,
+		f,
+		updateFieldQuery,
+		fld.ID,
+		fld.CategoryID,
+		fld.Name,
+		fld.Description,
+		fld.Options,
+	)
+
+	return f, errors.Wrap(err, "FieldRepository.Update.GetContext")
+}
+
+func (r *FieldRepository) Delete(ctx context.Context, categoryID, fieldID uuid.UUID) error {
+	res, err := r.conn.ExecContext(ctx, deleteFieldQuery, fieldID, categoryID)
+	if err != nil {
+		return errors.Wrap(err, "FieldRepository.Delete.ExecContext")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "FieldRepository.Delete.RowsAffected")
+	}
+
+	if rowsAffected == 0 {
+		return errors.Wrap(sql.ErrNoRows, "FieldRepository.Delete.NoRows")
+	}
+
+	return nil
+}
+
+func (r *FieldRepository) GetByID(ctx context.Context, categoryID, fieldID uuid.UUID) (*field.Model, error) {
+	f := &field.Model{}
+	err := r.conn.QueryRowxContext(
+		ctx,
+		getFieldByIDQuery,
+		fieldID,
+		categoryID,
+	).StructScan(f)
+
+	return f, errors.Wrap(err, "FieldRepository.GetByID.StructScan")
+}
+
+func (r *FieldRepository) GetByCategory(ctx context.Context, categoryID uuid.UUID) ([]*field.Model, error) {
+	fields := make([]*field.Model, 0)
+	err := r.conn.SelectContext(ctx, &fields, getFieldsByCategoryQuery, categoryID)
+	if err != nil {
+		return nil, errors.Wrap(err, "FieldRepository.GetByCategory.SelectContext")
+	}
+
+	return fields, nil
+}
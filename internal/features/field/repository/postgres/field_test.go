@@ -0,0 +1,145 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	"go-api/internal/core/field"
+	"go-api/internal/features/field/repository/postgres"
+)
+
+func TestFieldRepository_Create(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(createFieldQuery).
+			WithArgs(want.CategoryID, want.Name, want.Description, want.Type, `{"required":true,"values":["truck","van"]}`).
+			WillReturnRows(rows)
+
+		got, err := repo.Create(context.TODO(), want)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+}
+
+func TestFieldRepository_Update(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(updateFieldQuery).
+			WithArgs(want.ID, want.CategoryID, want.Name, want.Description, sqlmock.AnyArg()).
+			WillReturnRows(rows)
+
+		got, err := repo.Update(context.TODO(), want)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+}
+
+func TestFieldRepository_Delete(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, _ := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectExec(deleteFieldQuery).
+			WithArgs(want.ID, want.CategoryID).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		err := repo.Delete(context.TODO(), want.CategoryID, want.ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Fail with no rows", func(t *testing.T) {
+		db, repo, mock, want, _ := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectExec(deleteFieldQuery).
+			WithArgs(want.ID, want.CategoryID).
+			WillReturnResult(sqlmock.NewResult(1, 0))
+
+		err := repo.Delete(context.TODO(), want.CategoryID, want.ID)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestFieldRepository_GetByID(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(getFieldByIDQuery).
+			WithArgs(want.ID, want.CategoryID).
+			WillReturnRows(rows)
+
+		got, err := repo.GetByID(context.TODO(), want.CategoryID, want.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+}
+
+func TestFieldRepository_GetByCategory(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(getFieldsByCategoryQuery).
+			WithArgs(want.CategoryID).
+			WillReturnRows(rows)
+
+		got, err := repo.GetByCategory(context.TODO(), want.CategoryID)
+		assert.NoError(t, err)
+		assert.Equal(t, []*field.Model{want}, got)
+	})
+}
+
+func setupTest(t *testing.T) (*sql.DB, field.Repository, sqlmock.Sqlmock, *field.Model, *sqlmock.Rows) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	dbx := sqlx.NewDb(db, "sqlmock")
+	repo := postgres.NewFieldRepository(dbx)
+
+	want := &field.Model{
+		ID:          uuid.New(),
+		CategoryID:  uuid.New(),
+		Name:        "vehicle_type",
+		Description: "fake description",
+		Type:        field.TypeSingleSelect,
+		Options: field.Options{
+			Required: true,
+			Values:   []string{"truck", "van"},
+		},
+		CreatedAt: time.Now().UTC(),
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	rows := sqlmock.NewRows([]string{
+		"id",
+		"category_id",
+		"name",
+		"description",
+		"type",
+		"options",
+		"created_at",
+		"updated_at",
+	}).AddRow(
+		want.ID,
+		want.CategoryID,
+		want.Name,
+		want.Description,
+		want.Type,
+		[]byte(`{"required":true,"values":["truck","van"]}`),
+		want.CreatedAt,
+		want.UpdatedAt,
+	)
+
+	return db, repo, mock, want, rows
+}
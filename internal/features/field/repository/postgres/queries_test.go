@@ -0,0 +1,34 @@
+package postgres_test
+
+const (
+	createFieldQuery = `
+		INSERT INTO fields \(category_id, name, description, type, options\)
+		VALUES \(\$1, \$2, \$3, \$4, \$5\)
+		RETURNING id, category_id, name, COALESCE\(description, ''\) AS description, type, options, created_at, updated_at
+	`
+
+	updateFieldQuery = `
+		UPDATE fields
+		SET name = \$3,
+			description = COALESCE\(NULLIF\(\$4, ''\), description\),
+			options = \$5,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE id = \$1 AND category_id = \$2
+		RETURNING id, category_id, name, COALESCE\(description, ''\) AS description, type, options, created_at, updated_at
+	`
+
+	deleteFieldQuery = `DELETE FROM fields WHERE id = \$1 AND category_id = \$2`
+
+	getFieldByIDQuery = `
+		SELECT id, category_id, name, COALESCE\(description, ''\) AS description, type, options, created_at, updated_at
+		FROM fields
+		WHERE id = \$1 AND category_id = \$2
+	`
+
+	getFieldsByCategoryQuery = `
+		SELECT id, category_id, name, COALESCE\(description, ''\) AS description, type, options, created_at, updated_at
+		FROM fields
+		WHERE category_id = \$1
+		ORDER BY name
+	`
+)
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"go-api/internal/core/category"
+	"go-api/internal/core/field"
+	"go-api/internal/core/user"
+	"go-api/pkg/apierrors"
+	"go-api/pkg/config"
+)
+
+type fieldUseCase struct {
+	cfg          *config.Config
+	repo         field.Repository
+	categoryRepo category.Repository
+}
+
+func NewFieldUseCase(cfg *config.Config, repo field.Repository, categoryRepo category.Repository) field.UseCase {
+	return &fieldUseCase{cfg: cfg, repo: repo, categoryRepo: categoryRepo}
+}
+
+func (uc *fieldUseCase) Create(ctx context.Context, fld *field.Model) (*field.Model, error) {
+	_, err := user.RequireRole(ctx, user.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	err = fld.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = uc.categoryRepo.GetByID(ctx, fld.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.Create(ctx, fld)
+}
+
+func (uc *fieldUseCase) Update(ctx context.Context, fld *field.Model) (*field.Model, error) {
+	_, err := user.RequireRole(ctx, user.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := uc.repo.GetByID(ctx, fld.CategoryID, fld.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Changing the type would invalidate the values already stored
+	if fld.Type != "" && fld.Type != current.Type {
+		return nil, apierrors.BadRequest("field type cannot be changed")
+	}
+	fld.Type = current.Type
+
+	err = fld.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.Update(ctx, fld)
+}
+
+func (uc *fieldUseCase) Delete(ctx context.Context, categoryID, fieldID uuid.UUID) error {
+	_, err := user.RequireRole(ctx, user.RoleAdmin)
+	if err != nil {
+		return err
+	}
+
+	return uc.repo.Delete(ctx, categoryID, fieldID)
+}
+
+func (uc *fieldUseCase) GetByID(ctx context.Context, categoryID, fieldID uuid.UUID) (*field.Model, error) {
+	return uc.repo.GetByID(ctx, categoryID, fieldID)
+}
+
+func (uc *fieldUseCase) GetByCategory(ctx context.Context, categoryID uuid.UUID) ([]*field.Model, error) {
+	_, err := uc.categoryRepo.GetByID(ctx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.GetByCategory(ctx, categoryID)
+}
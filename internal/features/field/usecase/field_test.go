@@ -0,0 +1,172 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"go-api/internal/core/category"
+	categorymock "go-api/internal/core/category/mocks"
+	"go-api/internal/core/field"
+	fieldmock "go-api/internal/core/field/mocks"
+	"go-api/internal/core/user"
+	"go-api/internal/features/field/usecase"
+	"go-api/pkg/apierrors"
+	"go-api/pkg/config"
+)
+
+func TestFieldUseCase_Create(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctx, repo, categoryRepo, uc := setupTest(t, user.RoleAdmin)
+
+		fld := &field.Model{
+			CategoryID: uuid.New(),
+			Name:       "hours",
+			Type:       field.TypeInteger,
+		}
+
+		categoryRepo.On("GetByID", ctx, fld.CategoryID).
+			Return(&category.Model{ID: fld.CategoryID}, nil).
+			Once()
+
+		repo.On("Create", ctx, fld).
+			Return(fld, nil).
+			Once()
+
+		got, err := uc.Create(ctx, fld)
+		assert.NoError(t, err)
+		assert.Equal(t, fld, got)
+	})
+
+	t.Run("Fail with invalid options", func(t *testing.T) {
+		ctx, _, _, uc := setupTest(t, user.RoleAdmin)
+
+		got, err := uc.Create(ctx, &field.Model{
+			CategoryID: uuid.New(),
+			Name:       "hours",
+			Type:       field.TypeInteger,
+			Options:    field.Options{Values: []string{"a"}},
+		})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusBadRequest, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with unknown category", func(t *testing.T) {
+		ctx, _, categoryRepo, uc := setupTest(t, user.RoleAdmin)
+
+		fld := &field.Model{
+			CategoryID: uuid.New(),
+			Name:       "hours",
+			Type:       field.TypeInteger,
+		}
+
+		categoryRepo.On("GetByID", ctx, fld.CategoryID).
+			Return(nil, sql.ErrNoRows).
+			Once()
+
+		got, err := uc.Create(ctx, fld)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+		assert.Nil(t, got)
+	})
+
+	t.Run("Fail with no admin", func(t *testing.T) {
+		ctx, _, _, uc := setupTest(t, user.RoleWorker)
+
+		got, err := uc.Create(ctx, &field.Model{Name: "hours", Type: field.TypeInteger})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusForbidden, apierrors.Parse(err).StatusCode())
+	})
+}
+
+func TestFieldUseCase_Update(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctx, repo, _, uc := setupTest(t, user.RoleAdmin)
+
+		current := &field.Model{
+			ID:         uuid.New(),
+			CategoryID: uuid.New(),
+			Name:       "hours",
+			Type:       field.TypeInteger,
+		}
+		fld := &field.Model{
+			ID:         current.ID,
+			CategoryID: current.CategoryID,
+			Name:       "duration",
+		}
+
+		repo.On("GetByID", ctx, current.CategoryID, current.ID).
+			Return(current, nil).
+			Once()
+
+		repo.On("Update", ctx, fld).
+			Return(fld, nil).
+			Once()
+
+		got, err := uc.Update(ctx, fld)
+		assert.NoError(t, err)
+		assert.Equal(t, field.TypeInteger, got.Type)
+	})
+
+	t.Run("Fail with type change", func(t *testing.T) {
+		ctx, repo, _, uc := setupTest(t, user.RoleAdmin)
+
+		current := &field.Model{
+			ID:         uuid.New(),
+			CategoryID: uuid.New(),
+			Name:       "hours",
+			Type:       field.TypeInteger,
+		}
+
+		repo.On("GetByID", ctx, current.CategoryID, current.ID).
+			Return(current, nil).
+			Once()
+
+		got, err := uc.Update(ctx, &field.Model{
+			ID:         current.ID,
+			CategoryID: current.CategoryID,
+			Name:       "hours",
+			Type:       field.TypeText,
+		})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusBadRequest, apierrors.Parse(err).StatusCode())
+	})
+}
+
+func TestFieldUseCase_Delete(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctx, repo, _, uc := setupTest(t, user.RoleAdmin)
+
+		categoryID, fieldID := uuid.New(), uuid.New()
+
+		repo.On("Delete", ctx, categoryID, fieldID).
+			Return(nil).
+			Once()
+
+		err := uc.Delete(ctx, categoryID, fieldID)
+		assert.NoError(t, err)
+	})
+}
+
+func setupTest(t *testing.T, role string) (context.Context, *fieldmock.Repository, *categorymock.Repository, field.UseCase) {
+	t.Helper()
+
+	repo := fieldmock.NewRepository(t)
+	categoryRepo := categorymock.NewRepository(t)
+	uc := usecase.NewFieldUseCase(&config.Config{}, repo, categoryRepo)
+
+	usr := &user.Model{
+		ID:    uuid.New(),
+		Email: "fake@mail.com",
+		Role:  role,
+	}
+	ctx := context.WithValue(context.TODO(), user.CtxKey{}, usr)
+
+	return ctx, repo, categoryRepo, uc
+}
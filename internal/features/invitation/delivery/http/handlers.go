@@ -0,0 +1,100 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"go-api/internal/core/invitation"
+	"go-api/pkg/apierrors"
+	"go-api/pkg/config"
+	"go-api/pkg/utils"
+)
+
+type invitationHandler struct {
+	cfg          *config.Config
+	invitationUC invitation.UseCase
+}
+
+func NewInvitationHandler(cfg *config.Config, invitationUC invitation.UseCase) invitation.Handlers {
+	return &invitationHandler{
+		cfg:          cfg,
+		invitationUC: invitationUC,
+	}
+}
+
+func (h *invitationHandler) Create() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		inv := &invitation.Model{}
+		err := c.Bind(inv)
+		if err != nil {
+			c.JSON(apierrors.BadRequest().JSON())
+			return
+		}
+
+		createdInv, err := h.invitationUC.Create(c, inv)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusCreated, createdInv)
+	}
+}
+
+func (h *invitationHandler) Accept() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		invitationID, err := uuid.Parse(c.Param("invitation_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		inv, err := h.invitationUC.Accept(c, invitationID)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusOK, inv)
+	}
+}
+
+func (h *invitationHandler) Decline() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		invitationID, err := uuid.Parse(c.Param("invitation_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		inv, err := h.invitationUC.Decline(c, invitationID)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusOK, inv)
+	}
+}
+
+func (h *invitationHandler) GetInvitations() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pagination, err := utils.GetPaginationFromCtx(c)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		status := invitation.Status(c.Query("status"))
+
+		invitations, err := h.invitationUC.GetInvitations(c, status, pagination)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusOK, invitations)
+	}
+}
@@ -0,0 +1,17 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"go-api/internal/core/invitation"
+	"go-api/internal/core/user"
+	"go-api/internal/middleware"
+)
+
+func MapInvitationRoutes(group *gin.RouterGroup, h invitation.Handlers, mw *middleware.Manager) {
+	group.Use(mw.AuthSession())
+	group.POST("", mw.RequirePermission(user.PermInvitationCreate), h.Create())
+	group.GET("", mw.RequirePermission(user.PermInvitationRead), h.GetInvitations())
+	group.POST("/:invitation_id/accept", mw.RequirePermission(user.PermInvitationRespond), h.Accept())
+	group.POST("/:invitation_id/decline", mw.RequirePermission(user.PermInvitationRespond), h.Decline())
+}
@@ -0,0 +1,150 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	"go-api/internal/core/invitation"
+	"go-api/pkg/utils"
+)
+
+type InvitationRepository struct {
+	conn *sqlx.DB
+}
+
+func NewInvitationRepository(db *sqlx.DB) invitation.Repository {
+	return &InvitationRepository{
+		conn: db,
+	}
+}
+
+// Create returns sql.ErrNoRows when the worker was already invited to the advertisement
+func (r *InvitationRepository) Create(ctx context.Context, inv *invitation.Model) (*invitation.Model, error) {
+	i := &invitation.Model{}
+	err := r.conn.QueryRowxContext(
+		ctx,
+		createInvitationQuery,
+		inv.AdvertisementID,
+		inv.CostumerID,
+		inv.WorkerID,
+		inv.Message,
+	).StructScan(i)
+
+	return i, errors.Wrap(err, "InvitationRepository.Create.StructScan")
+}
+
+func (r *InvitationRepository) GetByID(ctx context.Context, invitationID uuid.UUID) (*invitation.Model, error) {
+	i := &invitation.Model{}
+	err := r.conn.QueryRowxContext(ctx, getInvitationByIDQuery, invitationID).StructScan(i)
+
+	return i, errors.Wrap(err, "InvitationRepository.GetByID.StructScan")
+}
+
+// Accept marks the invitation as accepted and applies the worker to the
+// advertisement in one transaction. It returns sql.ErrNoRows when the
+// invitation is no longer pending or the advertisement stopped taking
+// candidates.
+func (r *InvitationRepository) Accept(ctx context.Context, invitationID uuid.UUID) (*invitation.Model, error) {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "InvitationRepository.Accept.BeginTxx")
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	i := &invitation.Model{}
+	err = tx.GetContext(ctx, i, acceptInvitationQuery, invitationID)
+	if err != nil {
+		return nil, errors.Wrap(err, "InvitationRepository.Accept.GetContext")
+	}
+
+	_, err = tx.ExecContext(ctx, createInvitedCandidateQuery, i.AdvertisementID, i.WorkerID)
+	if err != nil {
+		return nil, errors.Wrap(err, "InvitationRepository.Accept.ExecContext")
+	}
+
+	return i, errors.Wrap(tx.Commit(), "InvitationRepository.Accept.Commit")
+}
+
+// Decline returns sql.ErrNoRows when the invitation is no longer pending
+func (r *InvitationRepository) Decline(ctx context.Context, invitationID uuid.UUID) (*invitation.Model, error) {
+	i := &invitation.Model{}
+	err := r.conn.GetContext(ctx, i, declineInvitationQuery, invitationID)
+
+	return i, errors.Wrap(err, "InvitationRepository.Decline.GetContext")
+}
+
+// GetByCostumer returns the invitations the costumer sent, optionally only those of one status
+func (r *InvitationRepository) GetByCostumer(
+	ctx context.Context,
+	costumerID uuid.UUID,
+	status invitation.Status,
+	pagination *utils.PaginationQuery,
+) (*invitation.List, error) {
+	list, err := r.getList(
+		ctx,
+		getInvitationsCountByCostumerQuery,
+		getInvitationsByCostumerQuery,
+		pagination,
+		costumerID,
+		status,
+	)
+	return list, errors.Wrap(err, "InvitationRepository.GetByCostumer")
+}
+
+// GetByWorker returns the invitations the worker received, optionally only those of one status
+func (r *InvitationRepository) GetByWorker(
+	ctx context.Context,
+	workerID uuid.UUID,
+	status invitation.Status,
+	pagination *utils.PaginationQuery,
+) (*invitation.List, error) {
+	list, err := r.getList(
+		ctx,
+		getInvitationsCountByWorkerQuery,
+		getInvitationsByWorkerQuery,
+		pagination,
+		workerID,
+		status,
+	)
+	return list, errors.Wrap(err, "InvitationRepository.GetByWorker")
+}
+
+func (r *InvitationRepository) getList(
+	ctx context.Context,
+	countQuery string,
+	query string,
+	pagination *utils.PaginationQuery,
+	args ...any,
+) (*invitation.List, error) {
+	var totalCount int
+	err := r.conn.GetContext(ctx, &totalCount, countQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetContext")
+	}
+
+	invitations := make([]*invitation.Model, 0, pagination.GetSize())
+	invitationsList := &invitation.List{
+		TotalCount:  totalCount,
+		TotalPages:  pagination.GetTotalPages(totalCount),
+		Page:        pagination.GetPage(),
+		Size:        pagination.GetSize(),
+		HasMore:     pagination.GetHasMore(totalCount),
+		Invitations: &invitations,
+	}
+
+	if totalCount == 0 {
+		return invitationsList, nil
+	}
+
+	args = append(args, pagination.GetOffset(), pagination.GetLimit())
+	err = r.conn.SelectContext(ctx, &invitations, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "SelectContext")
+	}
+
+	invitationsList.Invitations = &invitations
+	return invitationsList, nil
+}
@@ -0,0 +1,184 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	"go-api/internal/core/invitation"
+	"go-api/internal/features/invitation/repository/postgres"
+	"go-api/pkg/utils"
+)
+
+func TestInvitationRepository_Create(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(createInvitationQuery).
+			WithArgs(want.AdvertisementID, want.CostumerID, want.WorkerID, want.Message).
+			WillReturnRows(rows)
+
+		got, err := repo.Create(context.TODO(), want)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("Fail with duplicated invitation", func(t *testing.T) {
+		db, repo, mock, want, _ := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(createInvitationQuery).
+			WithArgs(want.AdvertisementID, want.CostumerID, want.WorkerID, want.Message).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		_, err := repo.Create(context.TODO(), want)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestInvitationRepository_Accept(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(acceptInvitationQuery).
+			WithArgs(want.ID).
+			WillReturnRows(rows)
+		mock.ExpectExec(createInvitedCandidateQuery).
+			WithArgs(want.AdvertisementID, want.WorkerID).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		got, err := repo.Accept(context.TODO(), want.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Fail with answered invitation", func(t *testing.T) {
+		db, repo, mock, want, _ := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(acceptInvitationQuery).
+			WithArgs(want.ID).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectRollback()
+
+		got, err := repo.Accept(context.TODO(), want.ID)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+		assert.Nil(t, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestInvitationRepository_Decline(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(declineInvitationQuery).
+			WithArgs(want.ID).
+			WillReturnRows(rows)
+
+		got, err := repo.Decline(context.TODO(), want.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+}
+
+func TestInvitationRepository_GetByWorker(t *testing.T) {
+	pag := &utils.PaginationQuery{
+		Page: 1,
+		Size: 10,
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		totalRows := sqlmock.NewRows([]string{"count"}).AddRow(1)
+		mock.ExpectQuery(getInvitationsCountByWorkerQuery).
+			WithArgs(want.WorkerID, invitation.StatusPending).
+			WillReturnRows(totalRows)
+		mock.ExpectQuery(getInvitationsByWorkerQuery).
+			WithArgs(want.WorkerID, invitation.StatusPending, pag.GetOffset(), pag.GetLimit()).
+			WillReturnRows(rows)
+
+		got, err := repo.GetByWorker(context.TODO(), want.WorkerID, invitation.StatusPending, pag)
+		assert.NoError(t, err)
+		assert.Equal(t, []*invitation.Model{want}, *got.Invitations)
+	})
+}
+
+func TestInvitationRepository_GetByCostumer(t *testing.T) {
+	pag := &utils.PaginationQuery{
+		Page: 1,
+		Size: 10,
+	}
+
+	t.Run("Success without invitations", func(t *testing.T) {
+		db, repo, mock, want, _ := setupTest(t)
+		defer db.Close()
+
+		totalRows := sqlmock.NewRows([]string{"count"}).AddRow(0)
+		mock.ExpectQuery(getInvitationsCountByCostumerQuery).
+			WithArgs(want.CostumerID, "").
+			WillReturnRows(totalRows)
+
+		got, err := repo.GetByCostumer(context.TODO(), want.CostumerID, "", pag)
+		assert.NoError(t, err)
+		assert.Empty(t, *got.Invitations)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func setupTest(t *testing.T) (*sql.DB, invitation.Repository, sqlmock.Sqlmock, *invitation.Model, *sqlmock.Rows) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	dbx := sqlx.NewDb(db, "sqlmock")
+	repo := postgres.NewInvitationRepository(dbx)
+
+	want := &invitation.Model{
+		ID:              uuid.New(),
+		AdvertisementID: uuid.New(),
+		CostumerID:      uuid.New(),
+		WorkerID:        uuid.New(),
+		Status:          invitation.StatusPending,
+		Message:         "Interested?",
+		CreatedAt:       time.Now().UTC(),
+		UpdatedAt:       time.Now().UTC(),
+	}
+
+	rows := sqlmock.NewRows([]string{
+		"id",
+		"advertisement_id",
+		"costumer_id",
+		"worker_id",
+		"status",
+		"message",
+		"responded_at",
+		"created_at",
+		"updated_at",
+	}).AddRow(
+		want.ID,
+		want.AdvertisementID,
+		want.CostumerID,
+		want.WorkerID,
+		want.Status,
+		want.Message,
+		nil,
+		want.CreatedAt,
+		want.UpdatedAt,
+	)
+
+	return db, repo, mock, want, rows
+}
@@ -0,0 +1,77 @@
+package postgres
+
+const (
+	createInvitationQuery = `
+		INSERT INTO invitations (advertisement_id, costumer_id, worker_id, message)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (advertisement_id, worker_id) DO NOTHING
+		RETURNING id, advertisement_id, costumer_id, worker_id, status, message, responded_at, created_at, updated_at
+	`
+
+	getInvitationByIDQuery = `
+		SELECT id, advertisement_id, costumer_id, worker_id, status, message, responded_at, created_at, updated_at
+		FROM invitations
+		WHERE id = $1
+	`
+
+	acceptInvitationQuery = `
+		UPDATE invitations i
+		SET status = 'accepted',
+			responded_at = CURRENT_TIMESTAMP,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE i.id = $1
+			AND i.status = 'pending'
+			AND EXISTS (
+				SELECT 1
+				FROM advertisements a
+				WHERE a.id = i.advertisement_id AND a.status = 'opened' AND a.selected_cadidate IS NULL
+			)
+		RETURNING i.id, i.advertisement_id, i.costumer_id, i.worker_id, i.status, i.message, i.responded_at,
+			i.created_at, i.updated_at
+	`
+
+	createInvitedCandidateQuery = `
+		INSERT INTO candidates (advertisement_id, worker_id)
+		VALUES ($1, $2)
+		ON CONFLICT (advertisement_id, worker_id) DO NOTHING
+	`
+
+	declineInvitationQuery = `
+		UPDATE invitations
+		SET status = 'declined',
+			responded_at = CURRENT_TIMESTAMP,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND status = 'pending'
+		RETURNING id, advertisement_id, costumer_id, worker_id, status, message, responded_at, created_at, updated_at
+	`
+
+	getInvitationsCountByCostumerQuery = `
+		SELECT COUNT(id)
+		FROM invitations
+		WHERE costumer_id = $1 AND ($2::TEXT = '' OR status::TEXT = $2)
+	`
+
+	getInvitationsByCostumerQuery = `
+		SELECT id, advertisement_id, costumer_id, worker_id, status, message, responded_at, created_at, updated_at
+		FROM invitations
+		WHERE costumer_id = $1 AND ($2::TEXT = '' OR status::TEXT = $2)
+		ORDER BY created_at DESC
+		OFFSET $3
+		LIMIT $4
+	`
+
+	getInvitationsCountByWorkerQuery = `
+		SELECT COUNT(id)
+		FROM invitations
+		WHERE worker_id = $1 AND ($2::TEXT = '' OR status::TEXT = $2)
+	`
+
+	getInvitationsByWorkerQuery = `
+		SELECT id, advertisement_id, costumer_id, worker_id, status, message, responded_at, created_at, updated_at
+		FROM invitations
+		WHERE worker_id = $1 AND ($2::TEXT = '' OR status::TEXT = $2)
+		ORDER BY created_at DESC
+		OFFSET $3
+		LIMIT $4
+	`
+)
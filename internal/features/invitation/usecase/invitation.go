@@ -0,0 +1,203 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"go-api/internal/core/advertisement"
+	"go-api/internal/core/candidate"
+	"go-api/internal/core/invitation"
+	"go-api/internal/core/user"
+	"go-api/pkg/apierrors"
+	"go-api/pkg/config"
+	"go-api/pkg/utils"
+)
+
+type invitationUseCase struct {
+	cfg           *config.Config
+	repo          invitation.Repository
+	adRepo        advertisement.Repository
+	userRepo      user.Repository
+	candidateRepo candidate.Repository
+}
+
+func NewInvitationUseCase(
+	cfg *config.Config,
+	repo invitation.Repository,
+	adRepo advertisement.Repository,
+	userRepo user.Repository,
+	candidateRepo candidate.Repository,
+) invitation.UseCase {
+	return &invitationUseCase{
+		cfg:           cfg,
+		repo:          repo,
+		adRepo:        adRepo,
+		userRepo:      userRepo,
+		candidateRepo: candidateRepo,
+	}
+}
+
+// Create invites a worker to apply to one of the costumer's opened advertisements
+func (uc *invitationUseCase) Create(ctx context.Context, inv *invitation.Model) (*invitation.Model, error) {
+	usr, err := user.RequireRole(ctx, user.RoleCostumer)
+	if err != nil {
+		return nil, err
+	}
+
+	inv.CostumerID = usr.ID
+	err = inv.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	ad, err := uc.adRepo.GetByID(ctx, inv.AdvertisementID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apierrors.NotFound("advertisement not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !ad.IsOwner(usr.ID) {
+		return nil, apierrors.Forbidden("only the advertisement owner can invite workers")
+	}
+
+	err = checkTakingCandidates(ad)
+	if err != nil {
+		return nil, err
+	}
+
+	worker, err := uc.userRepo.GetByID(ctx, inv.WorkerID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apierrors.NotFound("worker not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !worker.HasRole(user.RoleWorker) {
+		return nil, apierrors.BadRequest("only workers can be invited")
+	}
+
+	_, err = uc.candidateRepo.GetByID(ctx, ad.ID, worker.ID)
+	if err == nil {
+		return nil, apierrors.Conflict("worker already applied to this advertisement")
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	createdInv, err := uc.repo.Create(ctx, inv)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apierrors.Conflict("worker already invited to this advertisement")
+	}
+
+	return createdInv, err
+}
+
+// Accept applies the invited worker to the advertisement
+func (uc *invitationUseCase) Accept(ctx context.Context, invitationID uuid.UUID) (*invitation.Model, error) {
+	inv, err := uc.getPendingInvitation(ctx, invitationID)
+	if err != nil {
+		return nil, err
+	}
+
+	ad, err := uc.adRepo.GetByID(ctx, inv.AdvertisementID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkTakingCandidates(ad)
+	if err != nil {
+		return nil, err
+	}
+
+	acceptedInv, err := uc.repo.Accept(ctx, invitationID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apierrors.Conflict("invitation is no longer pending")
+	}
+
+	return acceptedInv, err
+}
+
+func (uc *invitationUseCase) Decline(ctx context.Context, invitationID uuid.UUID) (*invitation.Model, error) {
+	_, err := uc.getPendingInvitation(ctx, invitationID)
+	if err != nil {
+		return nil, err
+	}
+
+	declinedInv, err := uc.repo.Decline(ctx, invitationID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apierrors.Conflict("invitation is no longer pending")
+	}
+
+	return declinedInv, err
+}
+
+// GetInvitations lists the invitations a costumer sent or a worker received
+func (uc *invitationUseCase) GetInvitations(
+	ctx context.Context,
+	status invitation.Status,
+	pq *utils.PaginationQuery,
+) (*invitation.List, error) {
+	usr, err := user.RequireRole(ctx, user.RoleCostumer, user.RoleWorker)
+	if err != nil {
+		return nil, err
+	}
+
+	if status != "" && !status.IsValid() {
+		return nil, apierrors.BadRequest("invalid status")
+	}
+
+	if usr.HasRole(user.RoleCostumer) {
+		return uc.repo.GetByCostumer(ctx, usr.ID, status, pq)
+	}
+
+	return uc.repo.GetByWorker(ctx, usr.ID, status, pq)
+}
+
+// getPendingInvitation returns the invitation when it was sent to the
+// worker in the context and is still waiting for an answer
+func (uc *invitationUseCase) getPendingInvitation(ctx context.Context, invitationID uuid.UUID) (*invitation.Model, error) {
+	usr, err := user.RequireRole(ctx, user.RoleWorker)
+	if err != nil {
+		return nil, err
+	}
+
+	inv, err := uc.repo.GetByID(ctx, invitationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if inv.WorkerID != usr.ID {
+		return nil, apierrors.Forbidden("invitation was sent to another worker")
+	}
+
+	if inv.Status != invitation.StatusPending {
+		return nil, apierrors.Conflict(fmt.Sprintf("invitation is %s", inv.Status))
+	}
+
+	return inv, nil
+}
+
+// checkTakingCandidates validates that workers can still apply to the advertisement
+func checkTakingCandidates(ad *advertisement.Model) error {
+	if ad.Status != advertisement.StatusOpened {
+		return apierrors.Conflict("advertisement is not opened")
+	}
+
+	if !ad.ExpirationDate.After(time.Now()) {
+		return apierrors.Conflict("advertisement has expired")
+	}
+
+	if ad.SelectedCandidate != nil {
+		return apierrors.Conflict("advertisement already has a selected candidate")
+	}
+
+	return nil
+}
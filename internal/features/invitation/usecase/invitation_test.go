@@ -0,0 +1,302 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"go-api/internal/core/advertisement"
+	advertisementmock "go-api/internal/core/advertisement/mocks"
+	"go-api/internal/core/candidate"
+	candidatemock "go-api/internal/core/candidate/mocks"
+	"go-api/internal/core/invitation"
+	invitationmock "go-api/internal/core/invitation/mocks"
+	"go-api/internal/core/user"
+	usermock "go-api/internal/core/user/mocks"
+	"go-api/internal/features/invitation/usecase"
+	"go-api/pkg/apierrors"
+	"go-api/pkg/config"
+	"go-api/pkg/utils"
+)
+
+func TestInvitationUseCase_Create(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleCostumer)
+
+		ad := fakeAdvertisement(usr.ID)
+		worker := &user.Model{ID: uuid.New(), Role: user.RoleWorker}
+		inv := &invitation.Model{AdvertisementID: ad.ID, WorkerID: worker.ID, Message: " Interested? "}
+		want := &invitation.Model{
+			AdvertisementID: ad.ID,
+			CostumerID:      usr.ID,
+			WorkerID:        worker.ID,
+			Message:         "Interested?",
+		}
+
+		m.adRepo.On("GetByID", ctx, ad.ID).Return(ad, nil).Once()
+		m.userRepo.On("GetByID", ctx, worker.ID).Return(worker, nil).Once()
+		m.candidateRepo.On("GetByID", ctx, ad.ID, worker.ID).Return(nil, sql.ErrNoRows).Once()
+		m.repo.On("Create", ctx, want).Return(want, nil).Once()
+
+		got, err := uc.Create(ctx, inv)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("Fail with duplicated invitation", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleCostumer)
+
+		ad := fakeAdvertisement(usr.ID)
+		worker := &user.Model{ID: uuid.New(), Role: user.RoleWorker}
+		inv := &invitation.Model{AdvertisementID: ad.ID, WorkerID: worker.ID}
+
+		m.adRepo.On("GetByID", ctx, ad.ID).Return(ad, nil).Once()
+		m.userRepo.On("GetByID", ctx, worker.ID).Return(worker, nil).Once()
+		m.candidateRepo.On("GetByID", ctx, ad.ID, worker.ID).Return(nil, sql.ErrNoRows).Once()
+		m.repo.On("Create", ctx, inv).Return(nil, sql.ErrNoRows).Once()
+
+		got, err := uc.Create(ctx, inv)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusConflict, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with worker already applied", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleCostumer)
+
+		ad := fakeAdvertisement(usr.ID)
+		worker := &user.Model{ID: uuid.New(), Role: user.RoleWorker}
+
+		m.adRepo.On("GetByID", ctx, ad.ID).Return(ad, nil).Once()
+		m.userRepo.On("GetByID", ctx, worker.ID).Return(worker, nil).Once()
+		m.candidateRepo.On("GetByID", ctx, ad.ID, worker.ID).Return(&candidate.Model{}, nil).Once()
+
+		got, err := uc.Create(ctx, &invitation.Model{AdvertisementID: ad.ID, WorkerID: worker.ID})
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusConflict, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail inviting a costumer", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleCostumer)
+
+		ad := fakeAdvertisement(usr.ID)
+		other := &user.Model{ID: uuid.New(), Role: user.RoleCostumer}
+
+		m.adRepo.On("GetByID", ctx, ad.ID).Return(ad, nil).Once()
+		m.userRepo.On("GetByID", ctx, other.ID).Return(other, nil).Once()
+
+		got, err := uc.Create(ctx, &invitation.Model{AdvertisementID: ad.ID, WorkerID: other.ID})
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusBadRequest, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with unknown advertisement", func(t *testing.T) {
+		ctx, _, m, uc := setupTest(t, user.RoleCostumer)
+
+		adID := uuid.New()
+		m.adRepo.On("GetByID", ctx, adID).Return(nil, sql.ErrNoRows).Once()
+
+		got, err := uc.Create(ctx, &invitation.Model{AdvertisementID: adID, WorkerID: uuid.New()})
+		assert.Nil(t, got)
+		assert.Equal(t, "advertisement not found", apierrors.Parse(err).Message)
+		assert.Equal(t, http.StatusNotFound, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with another owner", func(t *testing.T) {
+		ctx, _, m, uc := setupTest(t, user.RoleCostumer)
+
+		ad := fakeAdvertisement(uuid.New())
+		m.adRepo.On("GetByID", ctx, ad.ID).Return(ad, nil).Once()
+
+		got, err := uc.Create(ctx, &invitation.Model{AdvertisementID: ad.ID, WorkerID: uuid.New()})
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusForbidden, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with closed advertisement", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleCostumer)
+
+		ad := fakeAdvertisement(usr.ID)
+		ad.Status = advertisement.StatusCanceled
+		m.adRepo.On("GetByID", ctx, ad.ID).Return(ad, nil).Once()
+
+		got, err := uc.Create(ctx, &invitation.Model{AdvertisementID: ad.ID, WorkerID: uuid.New()})
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusConflict, apierrors.Parse(err).StatusCode())
+	})
+}
+
+func TestInvitationUseCase_Accept(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleWorker)
+
+		ad := fakeAdvertisement(uuid.New())
+		inv := fakeInvitation(ad, usr.ID)
+		accepted := *inv
+		accepted.Status = invitation.StatusAccepted
+
+		m.repo.On("GetByID", ctx, inv.ID).Return(inv, nil).Once()
+		m.adRepo.On("GetByID", ctx, ad.ID).Return(ad, nil).Once()
+		m.repo.On("Accept", ctx, inv.ID).Return(&accepted, nil).Once()
+
+		got, err := uc.Accept(ctx, inv.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, &accepted, got)
+	})
+
+	t.Run("Fail with another worker", func(t *testing.T) {
+		ctx, _, m, uc := setupTest(t, user.RoleWorker)
+
+		inv := fakeInvitation(fakeAdvertisement(uuid.New()), uuid.New())
+		m.repo.On("GetByID", ctx, inv.ID).Return(inv, nil).Once()
+
+		got, err := uc.Accept(ctx, inv.ID)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusForbidden, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with answered invitation", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleWorker)
+
+		inv := fakeInvitation(fakeAdvertisement(uuid.New()), usr.ID)
+		inv.Status = invitation.StatusDeclined
+		m.repo.On("GetByID", ctx, inv.ID).Return(inv, nil).Once()
+
+		got, err := uc.Accept(ctx, inv.ID)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusConflict, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with assigned advertisement", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleWorker)
+
+		ad := fakeAdvertisement(uuid.New())
+		selected := uuid.New()
+		ad.SelectedCandidate = &selected
+		inv := fakeInvitation(ad, usr.ID)
+
+		m.repo.On("GetByID", ctx, inv.ID).Return(inv, nil).Once()
+		m.adRepo.On("GetByID", ctx, ad.ID).Return(ad, nil).Once()
+
+		got, err := uc.Accept(ctx, inv.ID)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusConflict, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with concurrent answer", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleWorker)
+
+		ad := fakeAdvertisement(uuid.New())
+		inv := fakeInvitation(ad, usr.ID)
+
+		m.repo.On("GetByID", ctx, inv.ID).Return(inv, nil).Once()
+		m.adRepo.On("GetByID", ctx, ad.ID).Return(ad, nil).Once()
+		m.repo.On("Accept", ctx, inv.ID).Return(nil, sql.ErrNoRows).Once()
+
+		got, err := uc.Accept(ctx, inv.ID)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusConflict, apierrors.Parse(err).StatusCode())
+	})
+}
+
+func TestInvitationUseCase_Decline(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleWorker)
+
+		inv := fakeInvitation(fakeAdvertisement(uuid.New()), usr.ID)
+		declined := *inv
+		declined.Status = invitation.StatusDeclined
+
+		m.repo.On("GetByID", ctx, inv.ID).Return(inv, nil).Once()
+		m.repo.On("Decline", ctx, inv.ID).Return(&declined, nil).Once()
+
+		got, err := uc.Decline(ctx, inv.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, &declined, got)
+	})
+}
+
+func TestInvitationUseCase_GetInvitations(t *testing.T) {
+	pq := &utils.PaginationQuery{Page: 1, Size: 10}
+
+	t.Run("Success as costumer", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleCostumer)
+
+		list := &invitation.List{Invitations: &[]*invitation.Model{}}
+		m.repo.On("GetByCostumer", ctx, usr.ID, invitation.StatusPending, pq).Return(list, nil).Once()
+
+		got, err := uc.GetInvitations(ctx, invitation.StatusPending, pq)
+		assert.NoError(t, err)
+		assert.Equal(t, list, got)
+	})
+
+	t.Run("Success as worker", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleWorker)
+
+		list := &invitation.List{Invitations: &[]*invitation.Model{}}
+		m.repo.On("GetByWorker", ctx, usr.ID, invitation.Status(""), pq).Return(list, nil).Once()
+
+		got, err := uc.GetInvitations(ctx, "", pq)
+		assert.NoError(t, err)
+		assert.Equal(t, list, got)
+	})
+
+	t.Run("Fail with invalid status", func(t *testing.T) {
+		ctx, _, _, uc := setupTest(t, user.RoleWorker)
+
+		got, err := uc.GetInvitations(ctx, "archived", pq)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusBadRequest, apierrors.Parse(err).StatusCode())
+	})
+}
+
+func fakeAdvertisement(costumerID uuid.UUID) *advertisement.Model {
+	return &advertisement.Model{
+		ID:             uuid.New(),
+		CostumerID:     costumerID,
+		Status:         advertisement.StatusOpened,
+		ExpirationDate: time.Now().Add(24 * time.Hour),
+	}
+}
+
+func fakeInvitation(ad *advertisement.Model, workerID uuid.UUID) *invitation.Model {
+	return &invitation.Model{
+		ID:              uuid.New(),
+		AdvertisementID: ad.ID,
+		CostumerID:      ad.CostumerID,
+		WorkerID:        workerID,
+		Status:          invitation.StatusPending,
+	}
+}
+
+type mocks struct {
+	repo          *invitationmock.Repository
+	adRepo        *advertisementmock.Repository
+	userRepo      *usermock.Repository
+	candidateRepo *candidatemock.Repository
+}
+
+func setupTest(t *testing.T, role string) (context.Context, *user.Model, *mocks, invitation.UseCase) {
+	t.Helper()
+
+	m := &mocks{
+		repo:          invitationmock.NewRepository(t),
+		adRepo:        advertisementmock.NewRepository(t),
+		userRepo:      usermock.NewRepository(t),
+		candidateRepo: candidatemock.NewRepository(t),
+	}
+	uc := usecase.NewInvitationUseCase(&config.Config{}, m.repo, m.adRepo, m.userRepo, m.candidateRepo)
+
+	usr := &user.Model{
+		ID:    uuid.New(),
+		Email: "fake@mail.com",
+		Role:  role,
+	}
+	ctx := context.WithValue(context.TODO(), user.CtxKey{}, usr)
+
+	return ctx, usr, m, uc
+}
@@ -0,0 +1,200 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"go-api/internal/core/profile"
+	"go-api/pkg/apierrors"
+	"go-api/pkg/config"
+	"go-api/pkg/geo"
+	"go-api/pkg/utils"
+)
+
+type profileHandler struct {
+	cfg       *config.Config
+	profileUC profile.UseCase
+}
+
+func NewProfileHandler(cfg *config.Config, profileUC profile.UseCase) profile.Handlers {
+	return &profileHandler{
+		cfg:       cfg,
+		profileUC: profileUC,
+	}
+}
+
+func (h *profileHandler) GetMe() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		p, err := h.profileUC.GetMe(c)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusOK, p)
+	}
+}
+
+func (h *profileHandler) Update() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		p := &profile.Model{}
+		err := c.Bind(p)
+		if err != nil {
+			c.JSON(apierrors.BadRequest().JSON())
+			return
+		}
+
+		updatedProfile, err := h.profileUC.Update(c, p)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusOK, updatedProfile)
+	}
+}
+
+func (h *profileHandler) GetByWorker() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		workerID, err := uuid.Parse(c.Param("worker_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		p, err := h.profileUC.GetByWorker(c, workerID)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusOK, p)
+	}
+}
+
+func (h *profileHandler) Directory() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pagination, err := utils.GetPaginationFromCtx(c)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		query, err := parseDirectoryQuery(c)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		workers, err := h.profileUC.Directory(c, query, pagination)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusOK, workers)
+	}
+}
+
+// parseDirectoryQuery reads the optional directory filters from the query
+// string. The location filter is given as lat, lng and radius_km.
+func parseDirectoryQuery(c *gin.Context) (*profile.DirectoryQuery, error) {
+	query := &profile.DirectoryQuery{
+		Skill:    c.Query("skill"),
+		Currency: c.Query("currency"),
+	}
+
+	var err error
+	query.CategoryID, err = parseOptionalUUID(c, "category_id")
+	if err != nil {
+		return nil, err
+	}
+
+	lat, err := parseOptionalFloat(c, "lat")
+	if err != nil {
+		return nil, err
+	}
+
+	lng, err := parseOptionalFloat(c, "lng")
+	if err != nil {
+		return nil, err
+	}
+
+	if (lat == nil) != (lng == nil) {
+		return nil, apierrors.BadRequest("lat and lng must be given together")
+	}
+
+	if lat != nil {
+		query.Center = &geo.Point{Latitude: *lat, Longitude: *lng}
+
+		radius, err := parseOptionalFloat(c, "radius_km")
+		if err != nil {
+			return nil, err
+		}
+		if radius != nil {
+			query.RadiusKm = *radius
+		}
+	}
+
+	query.MinRating, err = parseOptionalFloat(c, "min_rating")
+	if err != nil {
+		return nil, err
+	}
+
+	query.MinRate, err = parseOptionalInt(c, "min_rate")
+	if err != nil {
+		return nil, err
+	}
+
+	query.MaxRate, err = parseOptionalInt(c, "max_rate")
+	if err != nil {
+		return nil, err
+	}
+
+	return query, nil
+}
+
+func parseOptionalFloat(c *gin.Context, key string) (*float64, error) {
+	value := c.Query(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	n, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, apierrors.BadRequest("invalid " + key)
+	}
+
+	return &n, nil
+}
+
+func parseOptionalInt(c *gin.Context, key string) (*int64, error) {
+	value := c.Query(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, apierrors.BadRequest("invalid " + key)
+	}
+
+	return &n, nil
+}
+
+func parseOptionalUUID(c *gin.Context, key string) (*uuid.UUID, error) {
+	value := c.Query(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, apierrors.BadRequest("invalid " + key)
+	}
+
+	return &id, nil
+}
@@ -0,0 +1,19 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"go-api/internal/core/profile"
+	"go-api/internal/core/user"
+	"go-api/internal/middleware"
+)
+
+func MapProfileRoutes(group *gin.RouterGroup, h profile.Handlers, mw *middleware.Manager) {
+	group.GET("", h.Directory())
+	group.GET("/:worker_id/profile", h.GetByWorker())
+
+	group.Use(mw.AuthSession())
+	group.Use(mw.RequirePermission(user.PermProfileManage))
+	group.GET("/me/profile", h.GetMe())
+	group.PUT("/me/profile", h.Update())
+}
@@ -0,0 +1,150 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	"go-api/internal/core/profile"
+	"go-api/pkg/geo"
+	"go-api/pkg/utils"
+)
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+type ProfileRepository struct {
+	conn *sqlx.DB
+}
+
+func NewProfileRepository(db *sqlx.DB) profile.Repository {
+	return &ProfileRepository{
+		conn: db,
+	}
+}
+
+// Upsert creates or replaces the worker profile together with its categories
+func (r *ProfileRepository) Upsert(ctx context.Context, prof *profile.Model) (*profile.Model, error) {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "ProfileRepository.Upsert.BeginTxx")
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	p := &profile.Model{}
+	err = tx.GetContext(
+		ctx,
+		p,
+		upsertProfileQuery,
+		prof.UserID,
+		prof.DisplayName,
+		prof.Bio,
+		prof.Skills,
+		prof.HourlyRate,
+		prof.Currency,
+		prof.ServiceArea,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "ProfileRepository.Upsert.GetContext")
+	}
+
+	_, err = tx.ExecContext(ctx, deleteProfileCategoriesQuery, p.UserID)
+	if err != nil {
+		return nil, errors.Wrap(err, "ProfileRepository.Upsert.ExecContext")
+	}
+
+	for _, categoryID := range prof.Categories {
+		_, err = tx.ExecContext(ctx, createProfileCategoryQuery, p.UserID, categoryID)
+		if err != nil {
+			return nil, errors.Wrap(err, "ProfileRepository.Upsert.createCategory")
+		}
+	}
+	p.Categories = prof.Categories
+
+	return p, errors.Wrap(tx.Commit(), "ProfileRepository.Upsert.Commit")
+}
+
+func (r *ProfileRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*profile.Model, error) {
+	p := &profile.Model{}
+	err := r.conn.GetContext(ctx, p, getProfileByUserIDQuery, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "ProfileRepository.GetByUserID.GetContext")
+	}
+
+	p.Categories = make([]uuid.UUID, 0)
+	err = r.conn.SelectContext(ctx, &p.Categories, getProfileCategoriesQuery, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "ProfileRepository.GetByUserID.SelectContext")
+	}
+
+	return p, nil
+}
+
+func (r *ProfileRepository) GetPublic(ctx context.Context, userID uuid.UUID) (*profile.Public, error) {
+	p := &profile.Public{}
+	err := r.conn.QueryRowxContext(ctx, getPublicProfileQuery, userID).Scan(p)
+
+	return p, errors.Wrap(err, "ProfileRepository.GetPublic.Scan")
+}
+
+// Directory lists the worker profiles matching the query. Workers are
+// ordered by reputation unless the distance order is requested.
+func (r *ProfileRepository) Directory(
+	ctx context.Context,
+	query *profile.DirectoryQuery,
+	pagination *utils.PaginationQuery,
+) (*profile.DirectoryList, error) {
+	args := []any{query.CategoryID, likeEscaper.Replace(query.Skill)}
+	args = append(args, locationArgs(query)...)
+	args = append(args, query.MinRating, query.MinRate, query.MaxRate, query.Currency)
+
+	var totalCount int
+	err := r.conn.GetContext(ctx, &totalCount, getDirectoryCountQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "ProfileRepository.Directory.GetContext")
+	}
+
+	workers := make([]*profile.DirectoryEntry, 0, pagination.GetSize())
+	workersList := &profile.DirectoryList{
+		TotalCount: totalCount,
+		TotalPages: pagination.GetTotalPages(totalCount),
+		Page:       pagination.GetPage(),
+		Size:       pagination.GetSize(),
+		HasMore:    pagination.GetHasMore(totalCount),
+		Workers:    &workers,
+	}
+
+	if totalCount == 0 {
+		return workersList, nil
+	}
+
+	args = append(args, pagination.GetOrderBy(), pagination.GetOffset(), pagination.GetLimit())
+	err = r.conn.SelectContext(ctx, &workers, getDirectoryQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "ProfileRepository.Directory.SelectContext")
+	}
+
+	workersList.Workers = &workers
+	return workersList, nil
+}
+
+// locationArgs returns the center, its bounding box and the radius of the
+// location filter, or nulls when the query has no location
+func locationArgs(query *profile.DirectoryQuery) []any {
+	if query.Center == nil {
+		return []any{nil, nil, nil, nil, nil, nil, nil}
+	}
+
+	box := geo.BoundingBox(*query.Center, query.RadiusKm)
+	return []any{
+		query.Center.Latitude,
+		query.Center.Longitude,
+		box.MinLatitude,
+		box.MaxLatitude,
+		box.MinLongitude,
+		box.MaxLongitude,
+		query.RadiusKm,
+	}
+}
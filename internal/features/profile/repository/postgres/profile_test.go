@@ -0,0 +1,264 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	"go-api/internal/core/profile"
+	"go-api/internal/features/profile/repository/postgres"
+	"go-api/pkg/geo"
+	"go-api/pkg/utils"
+)
+
+func TestProfileRepository_Upsert(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(upsertProfileQuery).
+			WithArgs(
+				want.UserID,
+				want.DisplayName,
+				want.Bio,
+				want.Skills,
+				want.HourlyRate,
+				want.Currency,
+				want.ServiceArea,
+			).
+			WillReturnRows(rows)
+		mock.ExpectExec(deleteProfileCategoriesQuery).
+			WithArgs(want.UserID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(createProfileCategoryQuery).
+			WithArgs(want.UserID, want.Categories[0]).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		got, err := repo.Upsert(context.TODO(), want)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Fail with missing category rolls back", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(upsertProfileQuery).WillReturnRows(rows)
+		mock.ExpectExec(deleteProfileCategoriesQuery).
+			WithArgs(want.UserID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(createProfileCategoryQuery).
+			WithArgs(want.UserID, want.Categories[0]).
+			WillReturnError(sql.ErrConnDone)
+		mock.ExpectRollback()
+
+		got, err := repo.Upsert(context.TODO(), want)
+		assert.ErrorIs(t, err, sql.ErrConnDone)
+		assert.Nil(t, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestProfileRepository_GetByUserID(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(getProfileByUserIDQuery).
+			WithArgs(want.UserID).
+			WillReturnRows(rows)
+		mock.ExpectQuery(getProfileCategoriesQuery).
+			WithArgs(want.UserID).
+			WillReturnRows(sqlmock.NewRows([]string{"category_id"}).AddRow(want.Categories[0]))
+
+		got, err := repo.GetByUserID(context.TODO(), want.UserID)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("Fail without profile", func(t *testing.T) {
+		db, repo, mock, want, _ := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(getProfileByUserIDQuery).
+			WithArgs(want.UserID).
+			WillReturnError(sql.ErrNoRows)
+
+		got, err := repo.GetByUserID(context.TODO(), want.UserID)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+		assert.Nil(t, got)
+	})
+}
+
+func TestProfileRepository_GetPublic(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, _ := setupTest(t)
+		defer db.Close()
+
+		public := &profile.Public{
+			UserID:      want.UserID,
+			DisplayName: want.DisplayName,
+			Skills:      want.Skills,
+			Categories:  want.Categories,
+			HourlyRate:  want.HourlyRate,
+			Currency:    want.Currency,
+			ServiceArea: &profile.PublicArea{City: "São Paulo", Country: "BR", RadiusKm: 30},
+		}
+		doc, err := json.Marshal(public)
+		assert.NoError(t, err)
+
+		mock.ExpectQuery(getPublicProfileQuery).
+			WithArgs(want.UserID).
+			WillReturnRows(sqlmock.NewRows([]string{"worker_profile_public"}).AddRow(doc))
+
+		got, err := repo.GetPublic(context.TODO(), want.UserID)
+		assert.NoError(t, err)
+		assert.Equal(t, public, got)
+	})
+}
+
+func TestProfileRepository_Directory(t *testing.T) {
+	pag := &utils.PaginationQuery{
+		Page:    1,
+		Size:    10,
+		OrderBy: profile.OrderByDistance,
+	}
+
+	t.Run("Success with location", func(t *testing.T) {
+		db, repo, mock, want, _ := setupTest(t)
+		defer db.Close()
+
+		minRating := 4.0
+		query := &profile.DirectoryQuery{
+			CategoryID: &want.Categories[0],
+			Skill:      "100%_pintura",
+			Center:     &geo.Point{Latitude: -23.56, Longitude: -46.64},
+			RadiusKm:   15,
+			MinRating:  &minRating,
+		}
+		box := geo.BoundingBox(*query.Center, query.RadiusKm)
+		args := []driver.Value{
+			query.CategoryID,
+			`100\%\_pintura`,
+			query.Center.Latitude,
+			query.Center.Longitude,
+			box.MinLatitude,
+			box.MaxLatitude,
+			box.MinLongitude,
+			box.MaxLongitude,
+			query.RadiusKm,
+			query.MinRating,
+			nil,
+			nil,
+			"",
+		}
+
+		totalRows := sqlmock.NewRows([]string{"count"}).AddRow(1)
+		mock.ExpectQuery(getDirectoryCountQuery).
+			WithArgs(args...).
+			WillReturnRows(totalRows)
+
+		doc, err := json.Marshal(&profile.Public{UserID: want.UserID, DisplayName: want.DisplayName})
+		assert.NoError(t, err)
+		rows := sqlmock.NewRows([]string{"profile", "review_count", "average_rating", "distance_km"}).
+			AddRow(doc, 12, 4.75, 2.3)
+		mock.ExpectQuery(getDirectoryQuery).
+			WithArgs(append(args, pag.GetOrderBy(), pag.GetOffset(), pag.GetLimit())...).
+			WillReturnRows(rows)
+
+		got, err := repo.Directory(context.TODO(), query, pag)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, got.TotalCount)
+		assert.Len(t, *got.Workers, 1)
+
+		worker := (*got.Workers)[0]
+		assert.Equal(t, want.UserID, worker.Profile.UserID)
+		assert.Equal(t, 12, worker.ReviewCount)
+		assert.Equal(t, 4.75, worker.AverageRating)
+		assert.Equal(t, 2.3, *worker.DistanceKm)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success without matches", func(t *testing.T) {
+		db, repo, mock, _, _ := setupTest(t)
+		defer db.Close()
+
+		query := &profile.DirectoryQuery{}
+
+		totalRows := sqlmock.NewRows([]string{"count"}).AddRow(0)
+		mock.ExpectQuery(getDirectoryCountQuery).
+			WithArgs(query.CategoryID, "", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "").
+			WillReturnRows(totalRows)
+
+		got, err := repo.Directory(context.TODO(), query, pag)
+		assert.NoError(t, err)
+		assert.Empty(t, *got.Workers)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func setupTest(t *testing.T) (*sql.DB, profile.Repository, sqlmock.Sqlmock, *profile.Model, *sqlmock.Rows) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	dbx := sqlx.NewDb(db, "sqlmock")
+	repo := postgres.NewProfileRepository(dbx)
+
+	rate := int64(8000)
+	want := &profile.Model{
+		UserID:      uuid.New(),
+		DisplayName: "Maria Pinturas",
+		Bio:         "Pintora há 10 anos",
+		Skills:      profile.Skills{"pintura", "textura"},
+		Categories:  []uuid.UUID{uuid.New()},
+		HourlyRate:  &rate,
+		Currency:    "BRL",
+		ServiceArea: &profile.ServiceArea{
+			City:     "São Paulo",
+			Country:  "BR",
+			RadiusKm: 30,
+			Point:    geo.Point{Latitude: -23.55, Longitude: -46.63},
+		},
+		CreatedAt: time.Now().UTC(),
+		UpdatedAt: time.Now().UTC(),
+	}
+	skills, err := json.Marshal(want.Skills)
+	assert.NoError(t, err)
+	area, err := json.Marshal(want.ServiceArea)
+	assert.NoError(t, err)
+
+	rows := sqlmock.NewRows([]string{
+		"user_id",
+		"display_name",
+		"bio",
+		"skills",
+		"hourly_rate",
+		"currency",
+		"service_area",
+		"created_at",
+		"updated_at",
+	}).AddRow(
+		want.UserID,
+		want.DisplayName,
+		want.Bio,
+		skills,
+		*want.HourlyRate,
+		want.Currency,
+		area,
+		want.CreatedAt,
+		want.UpdatedAt,
+	)
+
+	return db, repo, mock, want, rows
+}
@@ -0,0 +1,94 @@
+package postgres_test
+
+const (
+	upsertProfileQuery = `
+		INSERT INTO worker_profiles \(user_id, display_name, bio, skills, hourly_rate, currency, service_area\)
+		VALUES \(\$1, \$2, \$3, \$4, \$5, NULLIF\(\$6, ''\), \$7\)
+		ON CONFLICT \(user_id\) DO UPDATE
+		SET display_name = EXCLUDED\.display_name,
+			bio = EXCLUDED\.bio,
+			skills = EXCLUDED\.skills,
+			hourly_rate = EXCLUDED\.hourly_rate,
+			currency = EXCLUDED\.currency,
+			service_area = EXCLUDED\.service_area,
+			updated_at = CURRENT_TIMESTAMP
+		RETURNING user_id, display_name, bio, skills, hourly_rate, COALESCE\(currency, ''\) AS currency, service_area,
+			created_at, updated_at
+	`
+
+	deleteProfileCategoriesQuery = `DELETE FROM worker_categories WHERE user_id = \$1`
+
+	createProfileCategoryQuery = `
+		INSERT INTO worker_categories \(user_id, category_id\)
+		VALUES \(\$1, \$2\)
+	`
+
+	getProfileByUserIDQuery = `
+		SELECT user_id, display_name, bio, skills, hourly_rate, COALESCE\(currency, ''\) AS currency, service_area,
+			created_at, updated_at
+		FROM worker_profiles
+		WHERE user_id = \$1
+	`
+
+	getProfileCategoriesQuery = `
+		SELECT category_id
+		FROM worker_categories
+		WHERE user_id = \$1
+		ORDER BY category_id
+	`
+
+	getPublicProfileQuery = `
+		SELECT worker_profile_public\(user_id\)
+		FROM worker_profiles
+		WHERE user_id = \$1
+	`
+
+	// The directory queries share directoryConditions. The location filter
+	// ($3-$9) is skipped when no center is given; the bounding box in $5-$8
+	// discards far away rows through the coordinates index.
+	directoryConditions = `
+		WHERE \(\$1::UUID IS NULL OR EXISTS \(
+				SELECT 1 FROM worker_categories wc WHERE wc\.user_id = p\.user_id AND wc\.category_id = \$1
+			\)\)
+			AND \(\$2::TEXT = '' OR EXISTS \(
+				SELECT 1 FROM jsonb_array_elements_text\(p\.skills\) AS s\(skill\) WHERE s\.skill ILIKE '%' \|\| \$2 \|\| '%'
+			\)\)
+			AND \(\$3::DOUBLE PRECISION IS NULL OR \(
+				p\.service_area IS NOT NULL
+				AND p\.latitude BETWEEN \$5 AND \$6
+				AND p\.longitude BETWEEN \$7 AND \$8
+				AND earth_distance_km\(\$3, \$4, p\.latitude, p\.longitude\) <= \$9
+			\)\)
+			AND \(\$10::NUMERIC IS NULL OR COALESCE\(r\.rating_sum / NULLIF\(r\.review_count, 0\), 0\) >= \$10\)
+			AND \(\$11::BIGINT IS NULL OR p\.hourly_rate >= \$11\)
+			AND \(\$12::BIGINT IS NULL OR p\.hourly_rate <= \$12\)
+			AND \(\$13::TEXT = '' OR p\.currency = \$13\)
+	`
+
+	getDirectoryCountQuery = `
+		SELECT COUNT\(p\.user_id\)
+		FROM worker_profiles p
+		LEFT JOIN user_reputations r ON r\.user_id = p\.user_id
+	` + directoryConditions
+
+	// Distances are rounded up to whole kilometers, in the result and in the
+	// order, so querying from a few points does not reveal the private
+	// center of a service area
+	getDirectoryQuery = `
+		SELECT worker_profile_public\(p\.user_id\) AS profile,
+			COALESCE\(r\.review_count, 0\) AS review_count,
+			COALESCE\(ROUND\(r\.rating_sum / NULLIF\(r\.review_count, 0\), 2\), 0\) AS average_rating,
+			CASE WHEN \$3::DOUBLE PRECISION IS NOT NULL
+				THEN CEIL\(earth_distance_km\(\$3, \$4, p\.latitude, p\.longitude\)\)
+			END AS distance_km
+		FROM worker_profiles p
+		LEFT JOIN user_reputations r ON r\.user_id = p\.user_id
+	` + directoryConditions + `
+		ORDER BY
+			CASE WHEN \$14::TEXT = 'distance' THEN CEIL\(earth_distance_km\(\$3, \$4, p\.latitude, p\.longitude\)\) END,
+			reputation_score\(COALESCE\(r\.rating_sum, 0\), COALESCE\(r\.review_count, 0\)\) DESC,
+			p\.updated_at DESC
+		OFFSET \$15
+		LIMIT \$16
+	`
+)
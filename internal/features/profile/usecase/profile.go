@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"go-api/internal/core/category"
+	"go-api/internal/core/profile"
+	"go-api/internal/core/user"
+	"go-api/pkg/apierrors"
+	"go-api/pkg/config"
+	"go-api/pkg/utils"
+)
+
+type profileUseCase struct {
+	cfg          *config.Config
+	repo         profile.Repository
+	categoryRepo category.Repository
+}
+
+func NewProfileUseCase(
+	cfg *config.Config,
+	repo profile.Repository,
+	categoryRepo category.Repository,
+) profile.UseCase {
+	return &profileUseCase{
+		cfg:          cfg,
+		repo:         repo,
+		categoryRepo: categoryRepo,
+	}
+}
+
+func (uc *profileUseCase) GetMe(ctx context.Context) (*profile.Model, error) {
+	usr, err := user.RequireRole(ctx, user.RoleWorker)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.GetByUserID(ctx, usr.ID)
+}
+
+// Update replaces the profile of the worker in the context, creating it on
+// the first call
+func (uc *profileUseCase) Update(ctx context.Context, prof *profile.Model) (*profile.Model, error) {
+	usr, err := user.RequireRole(ctx, user.RoleWorker)
+	if err != nil {
+		return nil, err
+	}
+
+	prof.UserID = usr.ID
+	err = prof.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, categoryID := range prof.Categories {
+		_, err = uc.categoryRepo.GetByID(ctx, categoryID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apierrors.BadRequest("category " + categoryID.String() + " does not exist")
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return uc.repo.Upsert(ctx, prof)
+}
+
+func (uc *profileUseCase) GetByWorker(ctx context.Context, workerID uuid.UUID) (*profile.Public, error) {
+	return uc.repo.GetPublic(ctx, workerID)
+}
+
+func (uc *profileUseCase) Directory(
+	ctx context.Context,
+	query *profile.DirectoryQuery,
+	pq *utils.PaginationQuery,
+) (*profile.DirectoryList, error) {
+	err := query.Validate(pq.GetOrderBy())
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.Directory(ctx, query, pq)
+}
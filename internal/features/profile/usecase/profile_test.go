@@ -0,0 +1,213 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"go-api/internal/core/category"
+	categorymock "go-api/internal/core/category/mocks"
+	"go-api/internal/core/profile"
+	profilemock "go-api/internal/core/profile/mocks"
+	"go-api/internal/core/user"
+	"go-api/internal/features/profile/usecase"
+	"go-api/pkg/apierrors"
+	"go-api/pkg/config"
+	"go-api/pkg/geo"
+	"go-api/pkg/utils"
+)
+
+func TestProfileUseCase_Update(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleWorker)
+
+		categoryID := uuid.New()
+		rate := int64(8000)
+		p := &profile.Model{
+			DisplayName: "  Maria Pinturas ",
+			Skills:      profile.Skills{"Pintura", " pintura ", "textura", ""},
+			Categories:  []uuid.UUID{categoryID, categoryID},
+			HourlyRate:  &rate,
+			Currency:    "brl",
+			ServiceArea: &profile.ServiceArea{
+				City:     "São Paulo",
+				Country:  "br",
+				RadiusKm: 30,
+				Point:    geo.Point{Latitude: -23.55, Longitude: -46.63},
+			},
+		}
+		want := &profile.Model{
+			UserID:      usr.ID,
+			DisplayName: "Maria Pinturas",
+			Skills:      profile.Skills{"Pintura", "textura"},
+			Categories:  []uuid.UUID{categoryID},
+			HourlyRate:  &rate,
+			Currency:    "BRL",
+			ServiceArea: &profile.ServiceArea{
+				City:     "São Paulo",
+				Country:  "BR",
+				RadiusKm: 30,
+				Point:    geo.Point{Latitude: -23.55, Longitude: -46.63},
+			},
+		}
+
+		m.categoryRepo.On("GetByID", ctx, categoryID).Return(&category.Model{ID: categoryID}, nil).Once()
+		m.repo.On("Upsert", ctx, want).Return(want, nil).Once()
+
+		got, err := uc.Update(ctx, p)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("Fail with costumer", func(t *testing.T) {
+		ctx, _, _, uc := setupTest(t, user.RoleCostumer)
+
+		got, err := uc.Update(ctx, &profile.Model{DisplayName: "Maria"})
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusForbidden, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with unknown category", func(t *testing.T) {
+		ctx, _, m, uc := setupTest(t, user.RoleWorker)
+
+		categoryID := uuid.New()
+		m.categoryRepo.On("GetByID", ctx, categoryID).Return(nil, sql.ErrNoRows).Once()
+
+		got, err := uc.Update(ctx, &profile.Model{DisplayName: "Maria", Categories: []uuid.UUID{categoryID}})
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusBadRequest, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with invalid profile", func(t *testing.T) {
+		ctx, _, _, uc := setupTest(t, user.RoleWorker)
+
+		rate, negative := int64(8000), int64(-1)
+		profiles := []*profile.Model{
+			{DisplayName: "   "},
+			{DisplayName: "Maria", HourlyRate: &negative, Currency: "BRL"},
+			{DisplayName: "Maria", HourlyRate: &rate},
+			{DisplayName: "Maria", ServiceArea: &profile.ServiceArea{City: "São Paulo", Country: "BR"}},
+			{DisplayName: "Maria", ServiceArea: &profile.ServiceArea{Country: "BR", RadiusKm: 10}},
+		}
+
+		for _, p := range profiles {
+			got, err := uc.Update(ctx, p)
+			assert.Nil(t, got)
+			assert.Equal(t, http.StatusBadRequest, apierrors.Parse(err).StatusCode())
+		}
+	})
+}
+
+func TestProfileUseCase_GetMe(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleWorker)
+
+		want := &profile.Model{UserID: usr.ID, DisplayName: "Maria"}
+		m.repo.On("GetByUserID", ctx, usr.ID).Return(want, nil).Once()
+
+		got, err := uc.GetMe(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("Fail without profile", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleWorker)
+
+		m.repo.On("GetByUserID", ctx, usr.ID).Return(nil, sql.ErrNoRows).Once()
+
+		got, err := uc.GetMe(ctx)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusNotFound, apierrors.Parse(err).StatusCode())
+	})
+}
+
+func TestProfileUseCase_GetByWorker(t *testing.T) {
+	t.Run("Success without user in context", func(t *testing.T) {
+		_, _, m, uc := setupTest(t, user.RoleWorker)
+
+		workerID := uuid.New()
+		want := &profile.Public{UserID: workerID, DisplayName: "Maria"}
+		m.repo.On("GetPublic", context.TODO(), workerID).Return(want, nil).Once()
+
+		got, err := uc.GetByWorker(context.TODO(), workerID)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+}
+
+func TestProfileUseCase_Directory(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		_, _, m, uc := setupTest(t, user.RoleCostumer)
+
+		pq := &utils.PaginationQuery{Page: 1, Size: 10, OrderBy: profile.OrderByRating}
+		minRate, maxRate := int64(5000), int64(10000)
+		query := &profile.DirectoryQuery{Skill: " pintura ", MinRate: &minRate, MaxRate: &maxRate, Currency: "brl"}
+		list := &profile.DirectoryList{Workers: &[]*profile.DirectoryEntry{}}
+
+		m.repo.On("Directory", context.TODO(), &profile.DirectoryQuery{
+			Skill:    "pintura",
+			MinRate:  &minRate,
+			MaxRate:  &maxRate,
+			Currency: "BRL",
+		}, pq).Return(list, nil).Once()
+
+		got, err := uc.Directory(context.TODO(), query, pq)
+		assert.NoError(t, err)
+		assert.Equal(t, list, got)
+	})
+
+	t.Run("Fail with invalid query", func(t *testing.T) {
+		_, _, _, uc := setupTest(t, user.RoleCostumer)
+
+		center := &geo.Point{Latitude: -23.55, Longitude: -46.63}
+		minRating, minRate, maxRate := 6.0, int64(500), int64(100)
+		tests := []struct {
+			orderBy string
+			query   *profile.DirectoryQuery
+		}{
+			{"price", &profile.DirectoryQuery{}},
+			{profile.OrderByDistance, &profile.DirectoryQuery{}},
+			{"", &profile.DirectoryQuery{Center: center}},
+			{"", &profile.DirectoryQuery{Center: center, RadiusKm: profile.MaxDirectoryRadiusKm + 1}},
+			{"", &profile.DirectoryQuery{MinRating: &minRating}},
+			{"", &profile.DirectoryQuery{MinRate: &minRate, MaxRate: &maxRate, Currency: "BRL"}},
+			{"", &profile.DirectoryQuery{MinRate: &minRate}},
+		}
+
+		for _, tt := range tests {
+			pq := &utils.PaginationQuery{Page: 1, Size: 10, OrderBy: tt.orderBy}
+
+			got, err := uc.Directory(context.TODO(), tt.query, pq)
+			assert.Nil(t, got)
+			assert.Equal(t, http.StatusBadRequest, apierrors.Parse(err).StatusCode())
+		}
+	})
+}
+
+type mocks struct {
+	repo         *profilemock.Repository
+	categoryRepo *categorymock.Repository
+}
+
+func setupTest(t *testing.T, role string) (context.Context, *user.Model, *mocks, profile.UseCase) {
+	t.Helper()
+
+	m := &mocks{
+		repo:         profilemock.NewRepository(t),
+		categoryRepo: categorymock.NewRepository(t),
+	}
+	uc := usecase.NewProfileUseCase(&config.Config{}, m.repo, m.categoryRepo)
+
+	usr := &user.Model{
+		ID:    uuid.New(),
+		Email: "fake@mail.com",
+		Role:  role,
+	}
+	ctx := context.WithValue(context.TODO(), user.CtxKey{}, usr)
+
+	return ctx, usr, m, uc
+}
@@ -0,0 +1,150 @@
+package redisrepo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"go-api/internal/core/refresh"
+	"go-api/pkg/config"
+)
+
+// rotateScript marks the token as rotated and stores its successor in one
+// step, so a failed write never leaves a rotated token without a successor.
+// It returns 0 without writing anything when the token was already rotated.
+var rotateScript = redis.NewScript(`
+	if not redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2], 'NX') then
+		return 0
+	end
+	redis.call('SET', KEYS[2], ARGV[3], 'PX', ARGV[4])
+	redis.call('PEXPIRE', KEYS[3], ARGV[4])
+	redis.call('PEXPIRE', KEYS[4], ARGV[4])
+	return 1
+`)
+
+// refreshRepository keeps four kinds of keys: the tokens, a marker for every
+// token already rotated, one key per family that is still active and a set
+// of the families of every user. Revoking a family deletes its key, which
+// invalidates all its tokens.
+type refreshRepository struct {
+	conn *redis.Client
+	cfg  *config.Config
+}
+
+// Refresh token redis repository constructor
+func NewRefreshRepository(c *redis.Client, cfg *config.Config) refresh.Repository {
+	return &refreshRepository{
+		conn: c,
+		cfg:  cfg,
+	}
+}
+
+// Create stores the first token of a new family
+func (r *refreshRepository) Create(ctx context.Context, token *refresh.Token) error {
+	tokenBytes, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.conn.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, r.familyKey(token.FamilyID), token.UserID.String(), token.TTL())
+		pipe.Set(ctx, r.tokenKey(token.Hash), tokenBytes, token.TTL())
+		pipe.SAdd(ctx, r.userKey(token.UserID), token.FamilyID.String())
+		pipe.Expire(ctx, r.userKey(token.UserID), token.TTL())
+		return nil
+	})
+	return err
+}
+
+// Get returns the token stored under the hash, or redis.Nil when it expired
+// or its family was revoked
+func (r *refreshRepository) Get(ctx context.Context, hash string) (*refresh.Token, error) {
+	tokenBytes, err := r.conn.Get(ctx, r.tokenKey(hash)).Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	token := &refresh.Token{}
+	if err = json.Unmarshal(tokenBytes, token); err != nil {
+		return nil, err
+	}
+	token.Hash = hash
+
+	active, err := r.conn.Exists(ctx, r.familyKey(token.FamilyID)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if active == 0 {
+		return nil, redis.Nil
+	}
+
+	return token, nil
+}
+
+// Rotate marks the token as rotated and stores the next one of its family,
+// extending the family lifetime. It reports false without storing anything
+// when the token had already been rotated.
+func (r *refreshRepository) Rotate(ctx context.Context, token, next *refresh.Token) (bool, error) {
+	nextBytes, err := json.Marshal(next)
+	if err != nil {
+		return false, err
+	}
+
+	keys := []string{
+		r.rotatedKey(token.Hash),
+		r.tokenKey(next.Hash),
+		r.familyKey(next.FamilyID),
+		r.userKey(next.UserID),
+	}
+	rotated, err := rotateScript.Run(ctx, r.conn, keys,
+		next.Hash,
+		token.TTL().Milliseconds(),
+		nextBytes,
+		next.TTL().Milliseconds(),
+	).Int()
+	if err != nil {
+		return false, err
+	}
+
+	return rotated == 1, nil
+}
+
+// RevokeFamily invalidates every token of the family
+func (r *refreshRepository) RevokeFamily(ctx context.Context, familyID uuid.UUID) error {
+	return r.conn.Del(ctx, r.familyKey(familyID)).Err()
+}
+
+// RevokeUser invalidates every token family of the user
+func (r *refreshRepository) RevokeUser(ctx context.Context, userID uuid.UUID) error {
+	families, err := r.conn.SMembers(ctx, r.userKey(userID)).Result()
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(families)+1)
+	for _, family := range families {
+		keys = append(keys, fmt.Sprintf("%s:family:%s", r.cfg.Auth.RefreshPrefix, family))
+	}
+	keys = append(keys, r.userKey(userID))
+
+	return r.conn.Del(ctx, keys...).Err()
+}
+
+func (r *refreshRepository) tokenKey(hash string) string {
+	return fmt.Sprintf("%s:token:%s", r.cfg.Auth.RefreshPrefix, hash)
+}
+
+func (r *refreshRepository) rotatedKey(hash string) string {
+	return fmt.Sprintf("%s:rotated:%s", r.cfg.Auth.RefreshPrefix, hash)
+}
+
+func (r *refreshRepository) familyKey(familyID uuid.UUID) string {
+	return fmt.Sprintf("%s:family:%s", r.cfg.Auth.RefreshPrefix, familyID)
+}
+
+func (r *refreshRepository) userKey(userID uuid.UUID) string {
+	return fmt.Sprintf("%s:user:%s", r.cfg.Auth.RefreshPrefix, userID)
+}
@@ -0,0 +1,142 @@
+package redisrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-api/internal/core/refresh"
+	"go-api/internal/features/refresh/repository/redisrepo"
+	"go-api/pkg/config"
+)
+
+func TestRefreshRepository_Get(t *testing.T) {
+	refreshRepository := setupTest(t)
+
+	t.Run("Success", func(t *testing.T) {
+		_, token, err := refresh.New(uuid.New(), uuid.Nil, time.Hour)
+		require.NoError(t, err)
+
+		err = refreshRepository.Create(context.Background(), token)
+		assert.NoError(t, err)
+
+		got, err := refreshRepository.Get(context.Background(), token.Hash)
+		assert.NoError(t, err)
+		assert.Equal(t, token.Hash, got.Hash)
+		assert.Equal(t, token.FamilyID, got.FamilyID)
+		assert.Equal(t, token.UserID, got.UserID)
+	})
+
+	t.Run("Fail unknown token", func(t *testing.T) {
+		got, err := refreshRepository.Get(context.Background(), refresh.Hash("fake_refresh_token"))
+		assert.ErrorIs(t, err, redis.Nil)
+		assert.Nil(t, got)
+	})
+}
+
+func TestRefreshRepository_Rotate(t *testing.T) {
+	refreshRepository := setupTest(t)
+
+	t.Run("Success only once", func(t *testing.T) {
+		userID := uuid.New()
+		_, token, err := refresh.New(userID, uuid.Nil, time.Hour)
+		require.NoError(t, err)
+		require.NoError(t, refreshRepository.Create(context.Background(), token))
+
+		_, next, err := refresh.New(userID, token.FamilyID, time.Hour)
+		require.NoError(t, err)
+
+		rotated, err := refreshRepository.Rotate(context.Background(), token, next)
+		assert.NoError(t, err)
+		assert.True(t, rotated)
+
+		got, err := refreshRepository.Get(context.Background(), next.Hash)
+		assert.NoError(t, err)
+		assert.Equal(t, token.FamilyID, got.FamilyID)
+
+		_, replay, err := refresh.New(userID, token.FamilyID, time.Hour)
+		require.NoError(t, err)
+
+		rotated, err = refreshRepository.Rotate(context.Background(), token, replay)
+		assert.NoError(t, err)
+		assert.False(t, rotated)
+
+		_, err = refreshRepository.Get(context.Background(), replay.Hash)
+		assert.ErrorIs(t, err, redis.Nil)
+	})
+}
+
+func TestRefreshRepository_RevokeFamily(t *testing.T) {
+	refreshRepository := setupTest(t)
+
+	t.Run("Success", func(t *testing.T) {
+		userID := uuid.New()
+		_, token, err := refresh.New(userID, uuid.Nil, time.Hour)
+		require.NoError(t, err)
+		require.NoError(t, refreshRepository.Create(context.Background(), token))
+
+		_, next, err := refresh.New(userID, token.FamilyID, time.Hour)
+		require.NoError(t, err)
+		_, err = refreshRepository.Rotate(context.Background(), token, next)
+		require.NoError(t, err)
+
+		err = refreshRepository.RevokeFamily(context.Background(), token.FamilyID)
+		assert.NoError(t, err)
+
+		_, err = refreshRepository.Get(context.Background(), next.Hash)
+		assert.ErrorIs(t, err, redis.Nil)
+	})
+}
+
+func TestRefreshRepository_RevokeUser(t *testing.T) {
+	refreshRepository := setupTest(t)
+
+	t.Run("Success", func(t *testing.T) {
+		userID := uuid.New()
+		_, first, err := refresh.New(userID, uuid.Nil, time.Hour)
+		require.NoError(t, err)
+		require.NoError(t, refreshRepository.Create(context.Background(), first))
+
+		_, second, err := refresh.New(userID, uuid.Nil, time.Hour)
+		require.NoError(t, err)
+		require.NoError(t, refreshRepository.Create(context.Background(), second))
+
+		_, other, err := refresh.New(uuid.New(), uuid.Nil, time.Hour)
+		require.NoError(t, err)
+		require.NoError(t, refreshRepository.Create(context.Background(), other))
+
+		err = refreshRepository.RevokeUser(context.Background(), userID)
+		assert.NoError(t, err)
+
+		_, err = refreshRepository.Get(context.Background(), first.Hash)
+		assert.ErrorIs(t, err, redis.Nil)
+		_, err = refreshRepository.Get(context.Background(), second.Hash)
+		assert.ErrorIs(t, err, redis.Nil)
+		_, err = refreshRepository.Get(context.Background(), other.Hash)
+		assert.NoError(t, err)
+	})
+}
+
+func setupTest(t *testing.T) refresh.Repository {
+	t.Helper()
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	t.Cleanup(mr.Close)
+
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+
+	cfg := &config.Config{Auth: config.Auth{
+		RefreshPrefix: "api-refresh",
+	}}
+
+	return redisrepo.NewRefreshRepository(client, cfg)
+}
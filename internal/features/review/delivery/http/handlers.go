@@ -0,0 +1,101 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"go-api/internal/core/review"
+	"go-api/pkg/apierrors"
+	"go-api/pkg/config"
+	"go-api/pkg/utils"
+)
+
+type reviewHandler struct {
+	cfg      *config.Config
+	reviewUC review.UseCase
+}
+
+func NewReviewHandler(cfg *config.Config, reviewUC review.UseCase) review.Handlers {
+	return &reviewHandler{
+		cfg:      cfg,
+		reviewUC: reviewUC,
+	}
+}
+
+func (h *reviewHandler) Create() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		adID, err := uuid.Parse(c.Param("advertisement_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		rev := &review.Model{}
+		err = c.Bind(rev)
+		if err != nil {
+			c.JSON(apierrors.BadRequest().JSON())
+			return
+		}
+		rev.AdvertisementID = adID
+
+		createdReview, err := h.reviewUC.Create(c, rev)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusCreated, createdReview)
+	}
+}
+
+func (h *reviewHandler) GetByAdvertisement() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		adID, err := uuid.Parse(c.Param("advertisement_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		pagination, err := utils.GetPaginationFromCtx(c)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		reviews, err := h.reviewUC.GetByAdvertisement(c, adID, pagination)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusOK, reviews)
+	}
+}
+
+func (h *reviewHandler) GetByUser() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, err := uuid.Parse(c.Param("user_id"))
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		pagination, err := utils.GetPaginationFromCtx(c)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		direction := review.Direction(c.Query("direction"))
+
+		reviews, err := h.reviewUC.GetByUser(c, userID, direction, pagination)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
+			return
+		}
+
+		c.JSON(http.StatusOK, reviews)
+	}
+}
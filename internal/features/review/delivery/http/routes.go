@@ -0,0 +1,17 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"go-api/internal/core/review"
+	"go-api/internal/core/user"
+	"go-api/internal/middleware"
+)
+
+func MapReviewRoutes(adGroup, userGroup *gin.RouterGroup, h review.Handlers, mw *middleware.Manager) {
+	userGroup.GET("", h.GetByUser())
+
+	adGroup.GET("", mw.OptionalAuthSession(), h.GetByAdvertisement())
+	adGroup.Use(mw.AuthSession())
+	adGroup.POST("", mw.RequirePermission(user.PermReviewCreate), h.Create())
+}
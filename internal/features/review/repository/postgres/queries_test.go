@@ -0,0 +1,39 @@
+package postgres_test
+
+const (
+	createReviewQuery = `
+		INSERT INTO reviews \(advertisement_id, reviewer_id, reviewee_id, direction, rating, comment\)
+		VALUES \(\$1, \$2, \$3, \$4, \$5, \$6\)
+		ON CONFLICT \(advertisement_id, reviewer_id\) DO NOTHING
+		RETURNING id, advertisement_id, reviewer_id, reviewee_id, direction, rating, COALESCE\(comment, ''\) AS comment,
+			created_at, updated_at
+	`
+
+	getReviewsCountByAdvertisementQuery = `SELECT COUNT\(id\) FROM reviews WHERE advertisement_id = \$1`
+
+	getReviewsByAdvertisementQuery = `
+		SELECT id, advertisement_id, reviewer_id, reviewee_id, direction, rating, COALESCE\(comment, ''\) AS comment,
+			created_at, updated_at
+		FROM reviews
+		WHERE advertisement_id = \$1
+		ORDER BY created_at DESC
+		OFFSET \$2
+		LIMIT \$3
+	`
+
+	getReviewsCountByUserQuery = `
+		SELECT COUNT\(id\)
+		FROM reviews
+		WHERE reviewee_id = \$1 AND \(\$2::TEXT = '' OR direction::TEXT = \$2\)
+	`
+
+	getReviewsByUserQuery = `
+		SELECT id, advertisement_id, reviewer_id, reviewee_id, direction, rating, COALESCE\(comment, ''\) AS comment,
+			created_at, updated_at
+		FROM reviews
+		WHERE reviewee_id = \$1 AND \(\$2::TEXT = '' OR direction::TEXT = \$2\)
+		ORDER BY created_at DESC
+		OFFSET \$3
+		LIMIT \$4
+	`
+)
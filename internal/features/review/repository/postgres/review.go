@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	"go-api/internal/core/review"
+	"go-api/pkg/utils"
+)
+
+type ReviewRepository struct {
+	conn *sqlx.DB
+}
+
+func NewReviewRepository(db *sqlx.DB) review.Repository {
+	return &ReviewRepository{
+		conn: db,
+	}
+}
+
+// Create returns sql.ErrNoRows when the reviewer already reviewed the advertisement
+func (r *ReviewRepository) Create(ctx context.Context, rev *review.Model) (*review.Model, error) {
+	rv := &review.Model{}
+	err := r.conn.QueryRowxContext(
+		ctx,
+		createReviewQuery,
+		rev.AdvertisementID,
+		rev.ReviewerID,
+		rev.RevieweeID,
+		rev.Direction,
+		rev.Rating,
+		rev.Comment,
+	).StructScan(rv)
+
+	return rv, errors.Wrap(err, "ReviewRepository.Create.StructScan")
+}
+
+func (r *ReviewRepository) GetByAdvertisement(
+	ctx context.Context,
+	adID uuid.UUID,
+	pagination *utils.PaginationQuery,
+) (*review.List, error) {
+	list, err := r.getList(ctx, getReviewsCountByAdvertisementQuery, getReviewsByAdvertisementQuery, pagination, adID)
+	return list, errors.Wrap(err, "ReviewRepository.GetByAdvertisement")
+}
+
+// GetByUser returns the reviews the user received, optionally only those of one direction
+func (r *ReviewRepository) GetByUser(
+	ctx context.Context,
+	userID uuid.UUID,
+	direction review.Direction,
+	pagination *utils.PaginationQuery,
+) (*review.List, error) {
+	list, err := r.getList(ctx, getReviewsCountByUserQuery, getReviewsByUserQuery, pagination, userID, direction)
+	return list, errors.Wrap(err, "ReviewRepository.GetByUser")
+}
+
+func (r *ReviewRepository) getList(
+	ctx context.Context,
+	countQuery string,
+	query string,
+	pagination *utils.PaginationQuery,
+	args ...any,
+) (*review.List, error) {
+	var totalCount int
+	err := r.conn.GetContext(ctx, &totalCount, countQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetContext")
+	}
+
+	reviews := make([]*review.Model, 0, pagination.GetSize())
+	reviewsList := &review.List{
+		TotalCount: totalCount,
+		TotalPages: pagination.GetTotalPages(totalCount),
+		Page:       pagination.GetPage(),
+		Size:       pagination.GetSize(),
+		HasMore:    pagination.GetHasMore(totalCount),
+		Reviews:    &reviews,
+	}
+
+	if totalCount == 0 {
+		return reviewsList, nil
+	}
+
+	args = append(args, pagination.GetOffset(), pagination.GetLimit())
+	err = r.conn.SelectContext(ctx, &reviews, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "SelectContext")
+	}
+
+	reviewsList.Reviews = &reviews
+	return reviewsList, nil
+}
@@ -0,0 +1,151 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	"go-api/internal/core/review"
+	"go-api/internal/features/review/repository/postgres"
+	"go-api/pkg/utils"
+)
+
+func TestReviewRepository_Create(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(createReviewQuery).
+			WithArgs(want.AdvertisementID, want.ReviewerID, want.RevieweeID, want.Direction, want.Rating, want.Comment).
+			WillReturnRows(rows)
+
+		got, err := repo.Create(context.TODO(), want)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("Fail with duplicated review", func(t *testing.T) {
+		db, repo, mock, want, _ := setupTest(t)
+		defer db.Close()
+
+		mock.ExpectQuery(createReviewQuery).
+			WithArgs(want.AdvertisementID, want.ReviewerID, want.RevieweeID, want.Direction, want.Rating, want.Comment).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		_, err := repo.Create(context.TODO(), want)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestReviewRepository_GetByAdvertisement(t *testing.T) {
+	pag := &utils.PaginationQuery{
+		Page: 1,
+		Size: 10,
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		totalRows := sqlmock.NewRows([]string{"count"}).AddRow(1)
+		mock.ExpectQuery(getReviewsCountByAdvertisementQuery).
+			WithArgs(want.AdvertisementID).
+			WillReturnRows(totalRows)
+		mock.ExpectQuery(getReviewsByAdvertisementQuery).
+			WithArgs(want.AdvertisementID, pag.GetOffset(), pag.GetLimit()).
+			WillReturnRows(rows)
+
+		got, err := repo.GetByAdvertisement(context.TODO(), want.AdvertisementID, pag)
+		assert.NoError(t, err)
+		assert.Equal(t, []*review.Model{want}, *got.Reviews)
+	})
+}
+
+func TestReviewRepository_GetByUser(t *testing.T) {
+	pag := &utils.PaginationQuery{
+		Page: 1,
+		Size: 10,
+	}
+
+	t.Run("Success with direction", func(t *testing.T) {
+		db, repo, mock, want, rows := setupTest(t)
+		defer db.Close()
+
+		totalRows := sqlmock.NewRows([]string{"count"}).AddRow(1)
+		mock.ExpectQuery(getReviewsCountByUserQuery).
+			WithArgs(want.RevieweeID, want.Direction).
+			WillReturnRows(totalRows)
+		mock.ExpectQuery(getReviewsByUserQuery).
+			WithArgs(want.RevieweeID, want.Direction, pag.GetOffset(), pag.GetLimit()).
+			WillReturnRows(rows)
+
+		got, err := repo.GetByUser(context.TODO(), want.RevieweeID, want.Direction, pag)
+		assert.NoError(t, err)
+		assert.Equal(t, []*review.Model{want}, *got.Reviews)
+	})
+
+	t.Run("Success without reviews", func(t *testing.T) {
+		db, repo, mock, _, _ := setupTest(t)
+		defer db.Close()
+
+		userID := uuid.New()
+		totalRows := sqlmock.NewRows([]string{"count"}).AddRow(0)
+		mock.ExpectQuery(getReviewsCountByUserQuery).
+			WithArgs(userID, review.Direction("")).
+			WillReturnRows(totalRows)
+
+		got, err := repo.GetByUser(context.TODO(), userID, "", pag)
+		assert.NoError(t, err)
+		assert.Empty(t, *got.Reviews)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func setupTest(t *testing.T) (*sql.DB, review.Repository, sqlmock.Sqlmock, *review.Model, *sqlmock.Rows) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	dbx := sqlx.NewDb(db, "sqlmock")
+	repo := postgres.NewReviewRepository(dbx)
+
+	want := &review.Model{
+		ID:              uuid.New(),
+		AdvertisementID: uuid.New(),
+		ReviewerID:      uuid.New(),
+		RevieweeID:      uuid.New(),
+		Direction:       review.DirectionCostumerToWorker,
+		Rating:          4.5,
+		Comment:         "fake comment",
+		CreatedAt:       time.Now().UTC(),
+		UpdatedAt:       time.Now().UTC(),
+	}
+
+	rows := sqlmock.NewRows([]string{
+		"id",
+		"advertisement_id",
+		"reviewer_id",
+		"reviewee_id",
+		"direction",
+		"rating",
+		"comment",
+		"created_at",
+		"updated_at",
+	}).AddRow(
+		want.ID,
+		want.AdvertisementID,
+		want.ReviewerID,
+		want.RevieweeID,
+		want.Direction,
+		want.Rating,
+		want.Comment,
+		want.CreatedAt,
+		want.UpdatedAt,
+	)
+
+	return db, repo, mock, want, rows
+}
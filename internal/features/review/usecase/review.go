@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"go-api/internal/core/advertisement"
+	"go-api/internal/core/review"
+	"go-api/internal/core/user"
+	"go-api/pkg/apierrors"
+	"go-api/pkg/config"
+	"go-api/pkg/utils"
+)
+
+type reviewUseCase struct {
+	cfg    *config.Config
+	repo   review.Repository
+	adRepo advertisement.Repository
+}
+
+func NewReviewUseCase(cfg *config.Config, repo review.Repository, adRepo advertisement.Repository) review.UseCase {
+	return &reviewUseCase{cfg: cfg, repo: repo, adRepo: adRepo}
+}
+
+func (uc *reviewUseCase) Create(ctx context.Context, rev *review.Model) (*review.Model, error) {
+	usr, err := user.GetUserFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ad, err := uc.adRepo.GetByID(ctx, rev.AdvertisementID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case ad.IsOwner(usr.ID) && ad.SelectedCandidate != nil:
+		rev.RevieweeID = *ad.SelectedCandidate
+		rev.Direction = review.DirectionCostumerToWorker
+	case ad.SelectedCandidate != nil && *ad.SelectedCandidate == usr.ID:
+		rev.RevieweeID = ad.CostumerID
+		rev.Direction = review.DirectionWorkerToCostumer
+	default:
+		return nil, apierrors.Forbidden("only the parties of the advertisement can review it")
+	}
+
+	if ad.Status != advertisement.StatusCompleted {
+		return nil, apierrors.Conflict("only completed advertisements can be reviewed")
+	}
+
+	err = rev.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	rev.ReviewerID = usr.ID
+
+	createdReview, err := uc.repo.Create(ctx, rev)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apierrors.Conflict("already reviewed this advertisement")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return createdReview, nil
+}
+
+func (uc *reviewUseCase) GetByAdvertisement(
+	ctx context.Context,
+	adID uuid.UUID,
+	pq *utils.PaginationQuery,
+) (*review.List, error) {
+	ad, err := uc.adRepo.GetByID(ctx, adID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = advertisement.CheckVisible(ctx, uc.adRepo, ad, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.GetByAdvertisement(ctx, adID, pq)
+}
+
+func (uc *reviewUseCase) GetByUser(
+	ctx context.Context,
+	userID uuid.UUID,
+	direction review.Direction,
+	pq *utils.PaginationQuery,
+) (*review.List, error) {
+	if direction != "" && !direction.IsValid() {
+		return nil, apierrors.BadRequest("invalid direction")
+	}
+
+	return uc.repo.GetByUser(ctx, userID, direction, pq)
+}
@@ -0,0 +1,185 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"go-api/internal/core/advertisement"
+	advertisementmock "go-api/internal/core/advertisement/mocks"
+	"go-api/internal/core/review"
+	reviewmock "go-api/internal/core/review/mocks"
+	"go-api/internal/core/user"
+	"go-api/internal/features/review/usecase"
+	"go-api/pkg/apierrors"
+	"go-api/pkg/config"
+	"go-api/pkg/utils"
+)
+
+func TestReviewUseCase_Create(t *testing.T) {
+	t.Run("Success as selected worker", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleWorker)
+
+		ad := fakeAdvertisement()
+		ad.SelectedCandidate = &usr.ID
+		rev := &review.Model{AdvertisementID: ad.ID, Rating: 5, Comment: " great costumer "}
+		want := &review.Model{
+			AdvertisementID: ad.ID,
+			ReviewerID:      usr.ID,
+			RevieweeID:      ad.CostumerID,
+			Direction:       review.DirectionWorkerToCostumer,
+			Rating:          5,
+			Comment:         "great costumer",
+		}
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		m.repo.On("Create", ctx, want).
+			Return(want, nil).
+			Once()
+
+		got, err := uc.Create(ctx, rev)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("Fail with rating out of range", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleCostumer)
+
+		ad := fakeAdvertisement()
+		ad.CostumerID = usr.ID
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		got, err := uc.Create(ctx, &review.Model{AdvertisementID: ad.ID, Rating: 6})
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusBadRequest, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with advertisement not completed", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleCostumer)
+
+		ad := fakeAdvertisement()
+		ad.CostumerID = usr.ID
+		ad.Status = advertisement.StatusOpened
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		got, err := uc.Create(ctx, &review.Model{AdvertisementID: ad.ID, Rating: 4})
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusConflict, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with user outside the advertisement", func(t *testing.T) {
+		ctx, _, m, uc := setupTest(t, user.RoleWorker)
+
+		ad := fakeAdvertisement()
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		got, err := uc.Create(ctx, &review.Model{AdvertisementID: ad.ID, Rating: 4})
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusForbidden, apierrors.Parse(err).StatusCode())
+	})
+
+	t.Run("Fail with duplicated review", func(t *testing.T) {
+		ctx, usr, m, uc := setupTest(t, user.RoleCostumer)
+
+		ad := fakeAdvertisement()
+		ad.CostumerID = usr.ID
+
+		m.adRepo.On("GetByID", ctx, ad.ID).
+			Return(ad, nil).
+			Once()
+
+		m.repo.On("Create", ctx, &review.Model{
+			AdvertisementID: ad.ID,
+			ReviewerID:      usr.ID,
+			RevieweeID:      *ad.SelectedCandidate,
+			Direction:       review.DirectionCostumerToWorker,
+			Rating:          4,
+		}).
+			Return(nil, sql.ErrNoRows).
+			Once()
+
+		got, err := uc.Create(ctx, &review.Model{AdvertisementID: ad.ID, Rating: 4})
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusConflict, apierrors.Parse(err).StatusCode())
+	})
+}
+
+func TestReviewUseCase_GetByUser(t *testing.T) {
+	pq := &utils.PaginationQuery{Page: 1, Size: 10}
+
+	t.Run("Success with direction", func(t *testing.T) {
+		ctx, _, m, uc := setupTest(t, user.RoleCostumer)
+
+		userID := uuid.New()
+		list := &review.List{Reviews: &[]*review.Model{}}
+
+		m.repo.On("GetByUser", ctx, userID, review.DirectionCostumerToWorker, pq).
+			Return(list, nil).
+			Once()
+
+		got, err := uc.GetByUser(ctx, userID, review.DirectionCostumerToWorker, pq)
+		assert.NoError(t, err)
+		assert.Equal(t, list, got)
+	})
+
+	t.Run("Fail with invalid direction", func(t *testing.T) {
+		ctx, _, _, uc := setupTest(t, user.RoleCostumer)
+
+		got, err := uc.GetByUser(ctx, uuid.New(), "sideways", pq)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusBadRequest, apierrors.Parse(err).StatusCode())
+	})
+}
+
+func fakeAdvertisement() *advertisement.Model {
+	workerID := uuid.New()
+
+	return &advertisement.Model{
+		ID:                uuid.New(),
+		CostumerID:        uuid.New(),
+		Status:            advertisement.StatusCompleted,
+		SelectedCandidate: &workerID,
+		ExpirationDate:    time.Now().Add(24 * time.Hour),
+	}
+}
+
+type mocks struct {
+	repo   *reviewmock.Repository
+	adRepo *advertisementmock.Repository
+}
+
+func setupTest(t *testing.T, role string) (context.Context, *user.Model, *mocks, review.UseCase) {
+	t.Helper()
+
+	m := &mocks{
+		repo:   reviewmock.NewRepository(t),
+		adRepo: advertisementmock.NewRepository(t),
+	}
+	uc := usecase.NewReviewUseCase(&config.Config{}, m.repo, m.adRepo)
+
+	usr := &user.Model{
+		ID:    uuid.New(),
+		Email: "fake@mail.com",
+		Role:  role,
+	}
+	ctx := context.WithValue(context.TODO(), user.CtxKey{}, usr)
+
+	return ctx, usr, m, uc
+}
@@ -87,26 +87,54 @@ func (h *userHandler) Login() gin.HandlerFunc {
 	}
 }
 
-func (h *userHandler) Logout() gin.HandlerFunc {
+func (h *userHandler) Refresh() gin.HandlerFunc {
+	type Refresh struct {
+		RefreshToken string `json:"refresh_token" validate:"required"`
+	}
+
 	return func(c *gin.Context) {
-		sessionID, err := c.Cookie("session-id")
+		req := &Refresh{}
+		err := c.Bind(req)
+		if err != nil || req.RefreshToken == "" {
+			c.JSON(apierrors.BadRequest().JSON())
+			return
+		}
+
+		token, err := h.userUC.Refresh(c, req.RefreshToken)
 		if err != nil {
 			c.JSON(apierrors.Parse(err).JSON())
 			return
 		}
 
-		if sessionID == "" {
-			c.JSON(apierrors.Unauthorized().JSON())
+		c.JSON(http.StatusOK, token)
+	}
+}
+
+// Logout revokes the refresh tokens of the user, authenticated by session
+// cookie or bearer token, and ends the session when there is one
+func (h *userHandler) Logout() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		usr, err := user.GetUserFromCtx(c)
+		if err != nil {
+			c.JSON(apierrors.Parse(err).JSON())
 			return
 		}
 
-		err = h.sessionUC.DeleteByID(c, sessionID)
+		err = h.userUC.RevokeRefreshTokens(c, usr.ID)
 		if err != nil {
 			c.JSON(apierrors.Parse(err).JSON())
 			return
 		}
 
-		utils.DeleteSessionCookie(h.cfg, c, h.cfg.Session.Name)
+		if sessionID, err := c.Cookie(h.cfg.Session.Name); err == nil && sessionID != "" {
+			err = h.sessionUC.DeleteByID(c, sessionID)
+			if err != nil {
+				c.JSON(apierrors.Parse(err).JSON())
+				return
+			}
+
+			utils.DeleteSessionCookie(h.cfg, c, h.cfg.Session.Name)
+		}
 
 		c.Status(http.StatusNoContent)
 	}
@@ -170,7 +198,7 @@ func (h *userHandler) GetUserByID() gin.HandlerFunc {
 			return
 		}
 
-		user, err := h.userUC.GetByID(c, id)
+		user, err := h.userUC.GetProfile(c, id)
 		if err != nil {
 			c.JSON(apierrors.Parse(err).JSON())
 			return
@@ -210,26 +238,22 @@ func (h *userHandler) GetUsers() gin.HandlerFunc {
 	}
 }
 
+// GetMe returns the profile of the user authenticated by session cookie or
+// bearer token
 func (h *userHandler) GetMe() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		sessionID, err := c.Cookie("session-id")
+		usr, err := user.GetUserFromCtx(c)
 		if err != nil {
 			c.JSON(apierrors.Parse(err).JSON())
 			return
 		}
 
-		session, err := h.sessionUC.GetSessionByID(c, sessionID)
+		profile, err := h.userUC.GetProfile(c, usr.ID)
 		if err != nil {
 			c.JSON(apierrors.Parse(err).JSON())
 			return
 		}
 
-		user, err := h.userUC.GetByID(c, session.UserID)
-		if err != nil {
-			c.JSON(apierrors.Parse(err).JSON())
-			return
-		}
-
-		c.JSON(http.StatusOK, user)
+		c.JSON(http.StatusOK, profile)
 	}
 }
@@ -2,6 +2,7 @@ package http_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -44,7 +45,9 @@ func TestUserHandler_Register(t *testing.T) {
 			sessionID = "fake_session_id"
 		)
 
-		jwt, err := token.GenerateJWT(usr.Email, userID.String(), 60*time.Minute, cfg)
+		keys, err := token.NewKeySet(cfg)
+		assert.NoError(t, err)
+		jwt, err := keys.GenerateJWT(usr.Email, userID.String(), 60*time.Minute)
 		assert.NoError(t, err)
 		usrToken.Token = jwt
 
@@ -68,6 +71,31 @@ func TestUserHandler_Register(t *testing.T) {
 	})
 }
 
+func TestUserHandler_GetMe(t *testing.T) {
+	t.Run("Success without session cookie", func(t *testing.T) {
+		_, ctx, rw, userUC, _, h := setupTest(t)
+
+		usr := &user.Model{ID: uuid.New(), Email: "fake@mail.com"}
+		ctx.Request = ctx.Request.WithContext(context.WithValue(context.Background(), user.CtxKey{}, usr))
+
+		userUC.On("GetProfile", ctx, usr.ID).
+			Return(usr, nil).
+			Once()
+
+		h.GetMe()(ctx)
+
+		assert.Equal(t, http.StatusOK, rw.Result().StatusCode)
+	})
+
+	t.Run("Fail without user", func(t *testing.T) {
+		_, ctx, rw, _, _, h := setupTest(t)
+
+		h.GetMe()(ctx)
+
+		assert.Equal(t, http.StatusUnauthorized, rw.Result().StatusCode)
+	})
+}
+
 func setupTest(t *testing.T) (*config.Config, *gin.Context, *httptest.ResponseRecorder, *usermock.UseCase, *sessionmock.UseCase, user.Handlers) {
 	t.Helper()
 
@@ -76,7 +104,9 @@ func setupTest(t *testing.T) (*config.Config, *gin.Context, *httptest.ResponseRe
 
 	cfg := &config.Config{
 		Server: config.Server{
-			JWTSecret: "fake_secret",
+			JWTSecret:   "fake_secret",
+			JWTIssuer:   "fake_issuer",
+			JWTAudience: "fake_audience",
 		},
 		Session: config.Session{
 			Duration: 10 * time.Second,
@@ -87,7 +117,8 @@ func setupTest(t *testing.T) (*config.Config, *gin.Context, *httptest.ResponseRe
 
 	gin.SetMode(gin.TestMode)
 	w := httptest.NewRecorder()
-	ctx, _ := gin.CreateTestContext(w)
+	ctx, engine := gin.CreateTestContext(w)
+	engine.ContextWithFallback = true
 	ctx.Request = &http.Request{
 		Header: make(http.Header),
 	}
@@ -10,12 +10,13 @@ import (
 func MapUserRoutes(group *gin.RouterGroup, h user.Handlers, mw *middleware.Manager) {
 	group.POST("/register", h.Register())
 	group.POST("/login", h.Login())
-	group.POST("/logout", h.Logout())
+	group.POST("/refresh", h.Refresh())
+	group.POST("/logout", mw.AuthSession(), h.Logout())
 
 	group.Use(mw.AuthSession())
-	group.GET("/all", h.GetUsers())
-	group.GET("/:user_id", h.GetUserByID())
+	group.GET("/all", mw.RequirePermission(user.PermUserList), h.GetUsers())
+	group.GET("/:user_id", mw.RequirePermission(user.PermUserRead), h.GetUserByID())
 	group.GET("/me", h.GetMe())
-	group.PUT("/:user_id", h.Update())
-	group.DELETE("/:user_id", h.Delete())
+	group.PUT("/:user_id", mw.RequirePermission(user.PermUserUpdate), h.Update())
+	group.DELETE("/:user_id", mw.RequirePermission(user.PermUserDelete), h.Delete())
 }
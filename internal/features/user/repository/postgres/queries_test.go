@@ -39,4 +39,21 @@ const (
 		OFFSET \$2
 		LIMIT \$3
 	`
+
+	getUserReputationQuery = `
+		SELECT u\.id AS user_id,
+			COALESCE\(r\.review_count, 0\) AS review_count,
+			COALESCE\(ROUND\(r\.rating_sum / NULLIF\(r\.review_count, 0\), 2\), 0\) AS average_rating,
+			reputation_score\(COALESCE\(r\.rating_sum, 0\), COALESCE\(r\.review_count, 0\)\) AS score,
+			json_build_object\(
+				'1', COALESCE\(r\.rating_1, 0\),
+				'2', COALESCE\(r\.rating_2, 0\),
+				'3', COALESCE\(r\.rating_3, 0\),
+				'4', COALESCE\(r\.rating_4, 0\),
+				'5', COALESCE\(r\.rating_5, 0\)
+			\) AS histogram
+		FROM users u
+		LEFT JOIN user_reputations r ON r\.user_id = u\.id
+		WHERE u\.id = \$1
+	`
 )
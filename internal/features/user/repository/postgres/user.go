@@ -79,6 +79,19 @@ func (r *UserRepository) GetByID(ctx context.Context, userID uuid.UUID) (*user.M
 	return u, errors.Wrap(err, "UserRepository.GetByID.StructScan")
 }
 
+// GetReputation returns the review summary of the user, all zeros when the
+// user was never reviewed
+func (r *UserRepository) GetReputation(ctx context.Context, userID uuid.UUID) (*user.Reputation, error) {
+	rep := &user.Reputation{}
+	err := r.conn.QueryRowxContext(
+		ctx,
+		getUserReputationQuery,
+		userID,
+	).StructScan(rep)
+
+	return rep, errors.Wrap(err, "UserRepository.GetReputation.StructScan")
+}
+
 func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*user.Model, error) {
 	u := &user.Model{}
 	err := r.conn.QueryRowxContext(
@@ -87,6 +87,30 @@ func TestUserRepository_GetByID(t *testing.T) {
 	})
 }
 
+func TestUserRepository_GetReputation(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, repo, mock, want, _ := setupTest(t)
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"user_id", "review_count", "average_rating", "score", "histogram"}).
+			AddRow(want.ID, 2, 4.5, 3.2857, []byte(`{"1":0,"2":0,"3":0,"4":1,"5":1}`))
+
+		mock.ExpectQuery(getUserReputationQuery).
+			WithArgs(want.ID).
+			WillReturnRows(rows)
+
+		got, err := repo.GetReputation(context.TODO(), want.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, &user.Reputation{
+			UserID:        want.ID,
+			ReviewCount:   2,
+			AverageRating: 4.5,
+			Score:         3.2857,
+			Histogram:     user.Histogram{1: 0, 2: 0, 3: 0, 4: 1, 5: 1},
+		}, got)
+	})
+}
+
 func TestUserRepository_FindByEmail(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		db, repo, mock, want, rows := setupTest(t)
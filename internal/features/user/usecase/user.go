@@ -2,10 +2,13 @@ package usecase
 
 import (
 	"context"
-	"time"
+	"database/sql"
+	"errors"
 
 	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
 
+	"go-api/internal/core/refresh"
 	"go-api/internal/core/user"
 	"go-api/pkg/apierrors"
 	"go-api/pkg/config"
@@ -14,12 +17,14 @@ import (
 )
 
 type userUseCase struct {
-	cfg  *config.Config
-	repo user.Repository
+	cfg         *config.Config
+	keys        *token.KeySet
+	repo        user.Repository
+	refreshRepo refresh.Repository
 }
 
-func NewUserUseCase(cfg *config.Config, repo user.Repository) user.UseCase {
-	return &userUseCase{cfg: cfg, repo: repo}
+func NewUserUseCase(cfg *config.Config, keys *token.KeySet, repo user.Repository, refreshRepo refresh.Repository) user.UseCase {
+	return &userUseCase{cfg: cfg, keys: keys, repo: repo, refreshRepo: refreshRepo}
 }
 
 func (uc *userUseCase) Register(ctx context.Context, usr *user.Model) (*user.Token, error) {
@@ -34,16 +39,7 @@ func (uc *userUseCase) Register(ctx context.Context, usr *user.Model) (*user.Tok
 	}
 	createdUser.Sanitize()
 
-	tokenDuration := 60 * time.Minute
-	jwt, err := token.GenerateJWT(createdUser.Email, createdUser.ID.String(), tokenDuration, uc.cfg)
-	if err != nil {
-		return nil, err
-	}
-
-	return &user.Token{
-		User:  createdUser,
-		Token: jwt,
-	}, nil
+	return uc.newToken(ctx, createdUser)
 }
 
 func (uc *userUseCase) Login(ctx context.Context, email, password string) (*user.Token, error) {
@@ -58,19 +54,62 @@ func (uc *userUseCase) Login(ctx context.Context, email, password string) (*user
 
 	foundUser.Sanitize()
 
-	tokenDuration := 60 * time.Minute
-	jwt, err := token.GenerateJWT(foundUser.Email, foundUser.ID.String(), tokenDuration, uc.cfg)
+	return uc.newToken(ctx, foundUser)
+}
+
+// Refresh rotates a refresh token, returning a new access token and the
+// next refresh token of the same family. Replaying a token that was already
+// rotated means it leaked, so the whole family is revoked.
+func (uc *userUseCase) Refresh(ctx context.Context, refreshToken string) (*user.Token, error) {
+	current, err := uc.refreshRepo.Get(ctx, refresh.Hash(refreshToken))
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, apierrors.Unauthorized()
+		}
+		return nil, err
+	}
+
+	usr, err := uc.repo.GetByID(ctx, current.UserID)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apierrors.Unauthorized()
+		}
 		return nil, err
 	}
+	usr.Sanitize()
 
-	return &user.Token{
-		User:  foundUser,
-		Token: jwt,
-	}, nil
+	value, next, err := refresh.New(current.UserID, current.FamilyID, uc.cfg.Auth.RefreshTokenDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	rotated, err := uc.refreshRepo.Rotate(ctx, current, next)
+	if err != nil {
+		return nil, err
+	}
+	if !rotated {
+		if err := uc.refreshRepo.RevokeFamily(ctx, current.FamilyID); err != nil {
+			return nil, err
+		}
+		return nil, apierrors.Unauthorized("refresh token reused")
+	}
+
+	return uc.accessToken(usr, value)
 }
 
+// Update changes a user. Users may only update themselves unless they can
+// manage users, which is also required to change a role. Changing the
+// password revokes the refresh tokens of the user.
 func (uc *userUseCase) Update(ctx context.Context, usr *user.Model) (*user.Model, error) {
+	caller, err := user.RequireOwner(ctx, usr.ID, user.PermUserManage)
+	if err != nil {
+		return nil, err
+	}
+
+	if usr.Role != "" && usr.Role != caller.Role && !caller.Can(user.PermUserManage) {
+		return nil, apierrors.Forbidden("only admins can change roles")
+	}
+
 	if usr.Password != "" {
 		err := usr.HashPassword()
 		if err != nil {
@@ -83,18 +122,35 @@ func (uc *userUseCase) Update(ctx context.Context, usr *user.Model) (*user.Model
 		return nil, err
 	}
 
+	if usr.Password != "" {
+		if err := uc.refreshRepo.RevokeUser(ctx, usr.ID); err != nil {
+			return nil, err
+		}
+	}
+
 	updatedUser.Sanitize()
 
 	return updatedUser, nil
 }
 
+// Delete removes a user. Users may only delete themselves unless they can
+// manage users.
 func (uc *userUseCase) Delete(ctx context.Context, userID uuid.UUID) error {
+	if _, err := user.RequireOwner(ctx, userID, user.PermUserManage); err != nil {
+		return err
+	}
+
 	err := uc.repo.Delete(ctx, userID)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return uc.refreshRepo.RevokeUser(ctx, userID)
+}
+
+// RevokeRefreshTokens revokes every refresh token family of the user
+func (uc *userUseCase) RevokeRefreshTokens(ctx context.Context, userID uuid.UUID) error {
+	return uc.refreshRepo.RevokeUser(ctx, userID)
 }
 
 func (uc *userUseCase) GetByID(ctx context.Context, userID uuid.UUID) (*user.Model, error) {
@@ -108,6 +164,49 @@ func (uc *userUseCase) GetByID(ctx context.Context, userID uuid.UUID) (*user.Mod
 	return usr, nil
 }
 
+// GetProfile returns the user with its reputation. GetByID stays a plain
+// lookup since the auth middleware calls it on every request.
+func (uc *userUseCase) GetProfile(ctx context.Context, userID uuid.UUID) (*user.Model, error) {
+	usr, err := uc.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	usr.Reputation, err = uc.repo.GetReputation(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return usr, nil
+}
+
+// newToken issues an access token and the first refresh token of a new family
+func (uc *userUseCase) newToken(ctx context.Context, usr *user.Model) (*user.Token, error) {
+	value, rt, err := refresh.New(usr.ID, uuid.Nil, uc.cfg.Auth.RefreshTokenDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.refreshRepo.Create(ctx, rt); err != nil {
+		return nil, err
+	}
+
+	return uc.accessToken(usr, value)
+}
+
+func (uc *userUseCase) accessToken(usr *user.Model, refreshToken string) (*user.Token, error) {
+	jwt, err := uc.keys.GenerateJWT(usr.Email, usr.ID.String(), uc.cfg.Auth.AccessTokenDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user.Token{
+		User:         usr,
+		Token:        jwt,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
 func (uc *userUseCase) GetUsers(ctx context.Context, pq *utils.PaginationQuery) (*user.List, error) {
 	list, err := uc.repo.GetUsers(ctx, pq)
 	if err != nil {
@@ -2,22 +2,32 @@ package usecase_test
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
+	testifymock "github.com/stretchr/testify/mock"
 
+	"go-api/internal/core/refresh"
+	refreshmock "go-api/internal/core/refresh/mocks"
 	"go-api/internal/core/user"
 	usermock "go-api/internal/core/user/mocks"
 	"go-api/internal/features/user/usecase"
+	"go-api/pkg/apierrors"
 	"go-api/pkg/config"
+	"go-api/pkg/token"
 	"go-api/pkg/utils"
 )
 
 func TestUserUseCase_Register(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
-		ctx, mock, uc := setupTest(t)
+		ctx, mock, refreshMock, uc := setupTest(t)
 
 		usr := &user.Model{
 			Password: "fake_password",
@@ -29,15 +39,21 @@ func TestUserUseCase_Register(t *testing.T) {
 			Return(usr, nil).
 			Once()
 
+		refreshMock.On("Create", ctx, testifymock.AnythingOfType("*refresh.Token")).
+			Return(nil).
+			Once()
+
 		got, err := uc.Register(ctx, usr)
 		assert.NoError(t, err)
 		assert.NotNil(t, got)
+		assert.NotEmpty(t, got.Token)
+		assert.NotEmpty(t, got.RefreshToken)
 	})
 }
 
 func TestUserUseCase_Login(t *testing.T) {
 	t.Run("Success with sanitize data", func(t *testing.T) {
-		ctx, mock, uc := setupTest(t)
+		ctx, mock, refreshMock, uc := setupTest(t)
 
 		password := "fake_password"
 
@@ -53,6 +69,12 @@ func TestUserUseCase_Login(t *testing.T) {
 			Return(usr, nil).
 			Once()
 
+		refreshMock.On("Create", ctx, testifymock.MatchedBy(func(rt *refresh.Token) bool {
+			return rt.UserID == usr.ID && rt.FamilyID != uuid.Nil
+		})).
+			Return(nil).
+			Once()
+
 		got, err := uc.Login(ctx, usr.Email, password)
 		assert.NoError(t, err, usr)
 		assert.NotNil(t, got)
@@ -60,20 +82,127 @@ func TestUserUseCase_Login(t *testing.T) {
 	})
 }
 
+func TestUserUseCase_Refresh(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctx, mock, refreshMock, uc := setupTest(t)
+
+		usr := &user.Model{ID: uuid.New(), Email: "fake@mail.com", Password: "fake_password"}
+		current := &refresh.Token{
+			Hash:      refresh.Hash("fake_refresh_token"),
+			FamilyID:  uuid.New(),
+			UserID:    usr.ID,
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+
+		refreshMock.On("Get", ctx, current.Hash).
+			Return(current, nil).
+			Once()
+
+		refreshMock.On("Rotate", ctx, current, testifymock.MatchedBy(func(next *refresh.Token) bool {
+			return next.FamilyID == current.FamilyID && next.UserID == usr.ID && next.Hash != current.Hash
+		})).
+			Return(true, nil).
+			Once()
+
+		mock.On("GetByID", ctx, usr.ID).
+			Return(usr, nil).
+			Once()
+
+		got, err := uc.Refresh(ctx, "fake_refresh_token")
+		assert.NoError(t, err)
+		assert.NotEmpty(t, got.Token)
+		assert.NotEmpty(t, got.RefreshToken)
+		assert.NotEqual(t, "fake_refresh_token", got.RefreshToken)
+		assert.Empty(t, got.User.Password)
+	})
+
+	t.Run("Fail reused token revokes family", func(t *testing.T) {
+		ctx, mock, refreshMock, uc := setupTest(t)
+
+		current := &refresh.Token{
+			Hash:      refresh.Hash("fake_refresh_token"),
+			FamilyID:  uuid.New(),
+			UserID:    uuid.New(),
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+
+		refreshMock.On("Get", ctx, current.Hash).
+			Return(current, nil).
+			Once()
+
+		mock.On("GetByID", ctx, current.UserID).
+			Return(&user.Model{ID: current.UserID}, nil).
+			Once()
+
+		refreshMock.On("Rotate", ctx, current, testifymock.AnythingOfType("*refresh.Token")).
+			Return(false, nil).
+			Once()
+
+		refreshMock.On("RevokeFamily", ctx, current.FamilyID).
+			Return(nil).
+			Once()
+
+		got, err := uc.Refresh(ctx, "fake_refresh_token")
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusUnauthorized, apierrors.Parse(err).HTTPStatus)
+	})
+
+	t.Run("Fail deleted user", func(t *testing.T) {
+		ctx, mock, refreshMock, uc := setupTest(t)
+
+		current := &refresh.Token{
+			Hash:      refresh.Hash("fake_refresh_token"),
+			FamilyID:  uuid.New(),
+			UserID:    uuid.New(),
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+
+		refreshMock.On("Get", ctx, current.Hash).
+			Return(current, nil).
+			Once()
+
+		mock.On("GetByID", ctx, current.UserID).
+			Return(nil, fmt.Errorf("UserRepository.GetByID.GetContext: %w", sql.ErrNoRows)).
+			Once()
+
+		got, err := uc.Refresh(ctx, "fake_refresh_token")
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusUnauthorized, apierrors.Parse(err).HTTPStatus)
+	})
+
+	t.Run("Fail unknown token", func(t *testing.T) {
+		ctx, _, refreshMock, uc := setupTest(t)
+
+		refreshMock.On("Get", ctx, refresh.Hash("fake_refresh_token")).
+			Return(nil, redis.Nil).
+			Once()
+
+		got, err := uc.Refresh(ctx, "fake_refresh_token")
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusUnauthorized, apierrors.Parse(err).HTTPStatus)
+	})
+}
+
 func TestUserUseCase_Update(t *testing.T) {
-	t.Run("Success with password", func(t *testing.T) {
-		ctx, mock, uc := setupTest(t)
+	t.Run("Success with password revokes refresh tokens", func(t *testing.T) {
+		ctx, mock, refreshMock, uc := setupTest(t)
 
 		usr := &user.Model{
+			ID:       uuid.New(),
 			Password: "fake_password",
 			Email:    "fake@mail.com",
-			Role:     "costumer",
+			Role:     user.RoleCostumer,
 		}
+		ctx = withUser(ctx, &user.Model{ID: usr.ID, Role: user.RoleCostumer})
 
 		mock.On("Update", ctx, usr).
 			Return(usr, nil).
 			Once()
 
+		refreshMock.On("RevokeUser", ctx, usr.ID).
+			Return(nil).
+			Once()
+
 		got, err := uc.Update(ctx, usr)
 		assert.NoError(t, err)
 		assert.NotNil(t, got)
@@ -81,12 +210,13 @@ func TestUserUseCase_Update(t *testing.T) {
 	})
 
 	t.Run("Success with no password", func(t *testing.T) {
-		ctx, mock, uc := setupTest(t)
+		ctx, mock, _, uc := setupTest(t)
 
 		usr := &user.Model{
+			ID:    uuid.New(),
 			Email: "fake@mail.com",
-			Role:  "Fake Name",
 		}
+		ctx = withUser(ctx, &user.Model{ID: usr.ID, Role: user.RoleWorker})
 
 		mock.On("Update", ctx, usr).
 			Return(usr, nil).
@@ -97,26 +227,104 @@ func TestUserUseCase_Update(t *testing.T) {
 		assert.NotNil(t, got)
 		assert.Empty(t, got.Password)
 	})
+
+	t.Run("Success admin changing another user role", func(t *testing.T) {
+		ctx, mock, _, uc := setupTest(t)
+
+		usr := &user.Model{
+			ID:   uuid.New(),
+			Role: user.RoleAdmin,
+		}
+		ctx = withUser(ctx, &user.Model{ID: uuid.New(), Role: user.RoleAdmin})
+
+		mock.On("Update", ctx, usr).
+			Return(usr, nil).
+			Once()
+
+		got, err := uc.Update(ctx, usr)
+		assert.NoError(t, err)
+		assert.NotNil(t, got)
+	})
+
+	t.Run("Fail another user", func(t *testing.T) {
+		ctx, _, _, uc := setupTest(t)
+
+		usr := &user.Model{
+			ID:    uuid.New(),
+			Email: "fake@mail.com",
+		}
+		ctx = withUser(ctx, &user.Model{ID: uuid.New(), Role: user.RoleWorker})
+
+		got, err := uc.Update(ctx, usr)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusForbidden, apierrors.Parse(err).HTTPStatus)
+	})
+
+	t.Run("Fail changing own role", func(t *testing.T) {
+		ctx, _, _, uc := setupTest(t)
+
+		usr := &user.Model{
+			ID:   uuid.New(),
+			Role: user.RoleAdmin,
+		}
+		ctx = withUser(ctx, &user.Model{ID: usr.ID, Role: user.RoleWorker})
+
+		got, err := uc.Update(ctx, usr)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusForbidden, apierrors.Parse(err).HTTPStatus)
+	})
+
+	t.Run("Fail without user", func(t *testing.T) {
+		ctx, _, _, uc := setupTest(t)
+
+		got, err := uc.Update(ctx, &user.Model{ID: uuid.New()})
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusUnauthorized, apierrors.Parse(err).HTTPStatus)
+	})
 }
 
 func TestUserUseCase_Delete(t *testing.T) {
-	t.Run("Success", func(t *testing.T) {
-		ctx, mock, uc := setupTest(t)
+	t.Run("Success revokes refresh tokens", func(t *testing.T) {
+		ctx, mock, refreshMock, uc := setupTest(t)
 
 		id := uuid.New()
+		ctx = withUser(ctx, &user.Model{ID: id, Role: user.RoleCostumer})
 
 		mock.On("Delete", ctx, id).
 			Return(nil).
 			Once()
 
+		refreshMock.On("RevokeUser", ctx, id).
+			Return(nil).
+			Once()
+
+		err := uc.Delete(ctx, id)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Success admin", func(t *testing.T) {
+		ctx, mock, refreshMock, uc := setupTest(t)
+
+		id := uuid.New()
+		ctx = withUser(ctx, &user.Model{ID: uuid.New(), Role: user.RoleAdmin})
+
+		mock.On("Delete", ctx, id).
+			Return(nil).
+			Once()
+
+		refreshMock.On("RevokeUser", ctx, id).
+			Return(nil).
+			Once()
+
 		err := uc.Delete(ctx, id)
 		assert.NoError(t, err)
 	})
 
 	t.Run("Fail", func(t *testing.T) {
-		ctx, mock, uc := setupTest(t)
+		ctx, mock, _, uc := setupTest(t)
 
 		id := uuid.New()
+		ctx = withUser(ctx, &user.Model{ID: id, Role: user.RoleCostumer})
 
 		mock.On("Delete", ctx, id).
 			Return(errors.New("fake_err")).
@@ -125,11 +333,20 @@ func TestUserUseCase_Delete(t *testing.T) {
 		err := uc.Delete(ctx, id)
 		assert.Error(t, err)
 	})
+
+	t.Run("Fail another user", func(t *testing.T) {
+		ctx, _, _, uc := setupTest(t)
+
+		ctx = withUser(ctx, &user.Model{ID: uuid.New(), Role: user.RoleCostumer})
+
+		err := uc.Delete(ctx, uuid.New())
+		assert.Equal(t, http.StatusForbidden, apierrors.Parse(err).HTTPStatus)
+	})
 }
 
 func TestUserUseCase_GetByID(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
-		ctx, mock, uc := setupTest(t)
+		ctx, mock, _, uc := setupTest(t)
 
 		usr := &user.Model{
 			ID:       uuid.New(),
@@ -146,12 +363,48 @@ func TestUserUseCase_GetByID(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotNil(t, got)
 		assert.Empty(t, got.Password)
+		assert.Nil(t, got.Reputation)
+	})
+}
+
+func TestUserUseCase_GetProfile(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctx, mock, _, uc := setupTest(t)
+
+		usr := &user.Model{
+			ID:       uuid.New(),
+			Password: "fake_password",
+			Email:    "fake@mail.com",
+			Role:     "Fake Name",
+		}
+
+		reputation := &user.Reputation{
+			UserID:        usr.ID,
+			ReviewCount:   2,
+			AverageRating: 4.5,
+			Score:         3.2857,
+			Histogram:     user.Histogram{1: 0, 2: 0, 3: 0, 4: 1, 5: 1},
+		}
+
+		mock.On("GetByID", ctx, usr.ID).
+			Return(usr, nil).
+			Once()
+
+		mock.On("GetReputation", ctx, usr.ID).
+			Return(reputation, nil).
+			Once()
+
+		got, err := uc.GetProfile(ctx, usr.ID)
+		assert.NoError(t, err)
+		assert.NotNil(t, got)
+		assert.Empty(t, got.Password)
+		assert.Equal(t, reputation, got.Reputation)
 	})
 }
 
 func TestUserUseCase_GetUsers(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
-		ctx, mock, uc := setupTest(t)
+		ctx, mock, _, uc := setupTest(t)
 
 		pq := &utils.PaginationQuery{
 			OrderBy: "",
@@ -196,17 +449,31 @@ func TestUserUseCase_GetUsers(t *testing.T) {
 	})
 }
 
-func setupTest(t *testing.T) (context.Context, *usermock.Repository, user.UseCase) {
+func setupTest(t *testing.T) (context.Context, *usermock.Repository, *refreshmock.Repository, user.UseCase) {
 	t.Helper()
 
 	cfg := &config.Config{
 		Server: config.Server{
-			JWTSecret: "fake_secret",
+			JWTSecret:   "fake_secret",
+			JWTIssuer:   "fake_issuer",
+			JWTAudience: "fake_audience",
+		},
+		Auth: config.Auth{
+			AccessTokenDuration:  15 * time.Minute,
+			RefreshTokenDuration: time.Hour,
 		},
 	}
 
+	keys, err := token.NewKeySet(cfg)
+	assert.NoError(t, err)
+
 	repo := usermock.NewRepository(t)
-	uc := usecase.NewUserUseCase(cfg, repo)
+	refreshRepo := refreshmock.NewRepository(t)
+	uc := usecase.NewUserUseCase(cfg, keys, repo, refreshRepo)
+
+	return context.TODO(), repo, refreshRepo, uc
+}
 
-	return context.TODO(), repo, uc
+func withUser(ctx context.Context, usr *user.Model) context.Context {
+	return context.WithValue(ctx, user.CtxKey{}, usr)
 }
@@ -2,8 +2,11 @@ package middleware
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
 
 	"go-api/internal/core/user"
 	"go-api/pkg/apierrors"
@@ -11,56 +14,134 @@ import (
 	"go-api/pkg/utils"
 )
 
+// AuthSession requires the user of the request, authenticated by its bearer
+// token when it sends an Authorization header and by its session cookie
+// otherwise
 func (m *Manager) AuthSession() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		requestID := utils.GetRequestID(c)
-
-		sessionID, err := c.Cookie(m.cfg.Session.Name)
-		if err != nil {
-			m.log.Warn("Failed getting session cookie in auth session middleware", logger.Fields{
-				"err":        err,
-				"request_id": requestID,
-			})
-
+		if !m.authenticate(c) {
 			c.JSON(apierrors.Unauthorized().JSON())
 			c.Abort()
 			return
 		}
 
-		session, err := m.sessionUC.GetSessionByID(c.Request.Context(), sessionID)
-		if err != nil {
-			m.log.Warn("Failed getting session by id in auth session middleware", logger.Fields{
-				"err":        err,
-				"request_id": requestID,
-			})
+		c.Next()
+	}
+}
 
-			c.JSON(apierrors.Unauthorized().JSON())
-			c.Abort()
-			return
+// OptionalAuthSession puts the user in the context when the request has a
+// bearer token or a session cookie that are valid and lets anonymous
+// requests through otherwise. It is meant for public routes whose answer
+// depends on who is asking.
+func (m *Manager) OptionalAuthSession() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		_, err := c.Cookie(m.cfg.Session.Name)
+		if hasAuthorization(c) || !errors.Is(err, http.ErrNoCookie) {
+			m.authenticate(c)
 		}
 
-		usr, err := m.userUC.GetByID(c.Request.Context(), session.UserID)
-		if err != nil {
-			m.log.Warn("Failed getting user by id in auth session middleware", logger.Fields{
-				"err":        err,
-				"request_id": requestID,
-			})
+		c.Next()
+	}
+}
 
-			c.JSON(apierrors.Unauthorized().JSON())
-			c.Abort()
-			return
-		}
+// authenticate loads the user of the bearer token or of the session cookie
+// into the request context and reports whether it succeeded
+func (m *Manager) authenticate(c *gin.Context) bool {
+	if hasAuthorization(c) {
+		return m.authenticateJWT(c)
+	}
 
-		ctx := context.WithValue(c.Request.Context(), user.CtxKey{}, usr)
-		c.Request = c.Request.WithContext(ctx)
+	return m.authenticateSession(c)
+}
 
-		m.log.Info("Succeeded auth session middleware", logger.Fields{
-			"request_id":     requestID,
-			"remote_address": utils.GetRemoteAddress(c),
-			"user_id":        usr.ID,
-			"session_id":     sessionID,
+func (m *Manager) authenticateSession(c *gin.Context) bool {
+	requestID := utils.GetRequestID(c)
+
+	sessionID, err := c.Cookie(m.cfg.Session.Name)
+	if err != nil {
+		m.log.Warn("Failed getting session cookie in auth session middleware", logger.Fields{
+			"err":        err,
+			"request_id": requestID,
 		})
+		return false
+	}
 
-		c.Next()
+	session, err := m.sessionUC.GetSessionByID(c.Request.Context(), sessionID)
+	if err != nil {
+		m.log.Warn("Failed getting session by id in auth session middleware", logger.Fields{
+			"err":        err,
+			"request_id": requestID,
+		})
+		return false
+	}
+
+	usr, err := m.userUC.GetByID(c.Request.Context(), session.UserID)
+	if err != nil {
+		m.log.Warn("Failed getting user by id in auth session middleware", logger.Fields{
+			"err":        err,
+			"request_id": requestID,
+		})
+		return false
+	}
+
+	setUser(c, usr)
+
+	m.log.Info("Succeeded auth session middleware", logger.Fields{
+		"request_id":     requestID,
+		"remote_address": utils.GetRemoteAddress(c),
+		"user_id":        usr.ID,
+		"session_id":     sessionID,
+	})
+
+	return true
+}
+
+func (m *Manager) authenticateJWT(c *gin.Context) bool {
+	requestID := utils.GetRequestID(c)
+
+	claims, err := m.keys.ExtractJWT(c.Request)
+	if err != nil {
+		m.log.Warn("Failed extracting jwt in auth jwt middleware", logger.Fields{
+			"err":        err,
+			"request_id": requestID,
+		})
+		return false
 	}
+
+	userID, err := uuid.Parse(claims.ID)
+	if err != nil {
+		m.log.Warn("Failed parsing user id of jwt in auth jwt middleware", logger.Fields{
+			"err":        err,
+			"request_id": requestID,
+		})
+		return false
+	}
+
+	usr, err := m.userUC.GetByID(c.Request.Context(), userID)
+	if err != nil {
+		m.log.Warn("Failed getting user by id in auth jwt middleware", logger.Fields{
+			"err":        err,
+			"request_id": requestID,
+		})
+		return false
+	}
+
+	setUser(c, usr)
+
+	m.log.Info("Succeeded auth jwt middleware", logger.Fields{
+		"request_id":     requestID,
+		"remote_address": utils.GetRemoteAddress(c),
+		"user_id":        usr.ID,
+	})
+
+	return true
+}
+
+func setUser(c *gin.Context, usr *user.Model) {
+	ctx := context.WithValue(c.Request.Context(), user.CtxKey{}, usr)
+	c.Request = c.Request.WithContext(ctx)
+}
+
+func hasAuthorization(c *gin.Context) bool {
+	return c.GetHeader("Authorization") != ""
 }
@@ -0,0 +1,88 @@
+package middleware_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"go-api/internal/core/user"
+	usermock "go-api/internal/core/user/mocks"
+	"go-api/internal/middleware"
+	"go-api/pkg/config"
+	loggermock "go-api/pkg/logger/mocks"
+	"go-api/pkg/token"
+)
+
+func TestManager_AuthSession(t *testing.T) {
+	cfg := &config.Config{
+		Server: config.Server{
+			JWTSecret:   "fake_secret",
+			JWTIssuer:   "fake_issuer",
+			JWTAudience: "fake_audience",
+		},
+	}
+	usr := &user.Model{ID: uuid.New(), Email: "fake@mail.com", Role: user.RoleWorker}
+
+	keys, err := token.NewKeySet(cfg)
+	assert.NoError(t, err)
+
+	valid, err := keys.GenerateJWT(usr.Email, usr.ID.String(), time.Minute)
+	assert.NoError(t, err)
+
+	expired, err := keys.GenerateJWT(usr.Email, usr.ID.String(), -time.Minute)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		header  string
+		userErr error
+		status  int
+	}{
+		{name: "Success with bearer token", header: "Bearer " + valid, status: http.StatusOK},
+		{name: "Fail without token nor cookie", status: http.StatusUnauthorized},
+		{name: "Fail with expired token", header: "Bearer " + expired, status: http.StatusUnauthorized},
+		{name: "Fail with deleted user", header: "Bearer " + valid, userErr: errors.New("fake_err"), status: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log := loggermock.NewLogger(t)
+			userUC := usermock.NewUseCase(t)
+			if tt.status == http.StatusOK {
+				log.On("Info", mock.Anything, mock.Anything).Return().Once()
+			} else {
+				log.On("Warn", mock.Anything, mock.Anything).Return().Once()
+			}
+			if tt.header == "Bearer "+valid {
+				userUC.On("GetByID", mock.Anything, usr.ID).Return(usr, tt.userErr).Once()
+			}
+
+			mw := middleware.New(cfg, keys, log, userUC, nil)
+
+			gin.SetMode(gin.TestMode)
+			r := gin.New()
+			r.GET("/", mw.AuthSession(), func(c *gin.Context) {
+				got, err := user.GetUserFromCtx(c.Request.Context())
+				assert.NoError(t, err)
+				assert.Equal(t, usr.ID, got.ID)
+				c.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
@@ -5,10 +5,12 @@ import (
 	"go-api/internal/core/user"
 	"go-api/pkg/config"
 	"go-api/pkg/logger"
+	"go-api/pkg/token"
 )
 
 type Manager struct {
 	cfg       *config.Config
+	keys      *token.KeySet
 	log       logger.Logger
 	userUC    user.UseCase
 	sessionUC session.UseCase
@@ -16,12 +18,14 @@ type Manager struct {
 
 func New(
 	cfg *config.Config,
+	keys *token.KeySet,
 	logger logger.Logger,
 	userUC user.UseCase,
 	sessionUC session.UseCase,
 ) *Manager {
 	return &Manager{
 		cfg:       cfg,
+		keys:      keys,
 		log:       logger,
 		userUC:    userUC,
 		sessionUC: sessionUC,
@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"go-api/internal/core/user"
+	"go-api/pkg/apierrors"
+	"go-api/pkg/logger"
+	"go-api/pkg/utils"
+)
+
+// RequirePermission lets the request through only when the role of the
+// session user grants every given permission. It must run after AuthSession.
+func (m *Manager) RequirePermission(perms ...user.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		usr, err := user.GetUserFromCtx(c.Request.Context())
+		if err != nil {
+			c.JSON(apierrors.Unauthorized().JSON())
+			c.Abort()
+			return
+		}
+
+		if !usr.Can(perms...) {
+			m.log.Warn("Permission denied in require permission middleware", logger.Fields{
+				"request_id":  utils.GetRequestID(c),
+				"user_id":     usr.ID,
+				"role":        usr.Role,
+				"permissions": perms,
+			})
+			c.JSON(apierrors.Forbidden().JSON())
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
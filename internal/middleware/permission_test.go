@@ -0,0 +1,88 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"go-api/internal/core/user"
+	"go-api/internal/middleware"
+	"go-api/pkg/config"
+	loggermock "go-api/pkg/logger/mocks"
+)
+
+func TestManager_RequirePermission(t *testing.T) {
+	tests := []struct {
+		name   string
+		usr    *user.Model
+		perms  []user.Permission
+		status int
+	}{
+		{
+			name:   "Success with granted permission",
+			usr:    &user.Model{ID: uuid.New(), Role: user.RoleAdmin},
+			perms:  []user.Permission{user.PermUserList},
+			status: http.StatusOK,
+		},
+		{
+			name:   "Success with every permission granted",
+			usr:    &user.Model{ID: uuid.New(), Role: user.RoleWorker},
+			perms:  []user.Permission{user.PermCandidateApply, user.PermOfferNegotiate},
+			status: http.StatusOK,
+		},
+		{
+			name:   "Fail with missing permission",
+			usr:    &user.Model{ID: uuid.New(), Role: user.RoleWorker},
+			perms:  []user.Permission{user.PermUserList},
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "Fail with one missing permission",
+			usr:    &user.Model{ID: uuid.New(), Role: user.RoleCostumer},
+			perms:  []user.Permission{user.PermAdvertisementCreate, user.PermCandidateApply},
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "Fail with unknown role",
+			usr:    &user.Model{ID: uuid.New(), Role: "guest"},
+			perms:  []user.Permission{user.PermUserRead},
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "Fail without user",
+			perms:  []user.Permission{user.PermUserRead},
+			status: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log := loggermock.NewLogger(t)
+			if tt.status == http.StatusForbidden {
+				log.On("Warn", mock.Anything, mock.Anything).Return().Once()
+			}
+			mw := middleware.New(&config.Config{}, nil, log, nil, nil)
+
+			gin.SetMode(gin.TestMode)
+			r := gin.New()
+			r.GET("/", func(c *gin.Context) {
+				if tt.usr != nil {
+					ctx := context.WithValue(c.Request.Context(), user.CtxKey{}, tt.usr)
+					c.Request = c.Request.WithContext(ctx)
+				}
+			}, mw.RequirePermission(tt.perms...), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
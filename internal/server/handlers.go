@@ -5,39 +5,111 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	advertisementhandler "go-api/internal/features/advertisement/delivery/http"
+	advertisementpub "go-api/internal/features/advertisement/publisher/redispub"
+	advertisementrepo "go-api/internal/features/advertisement/repository/postgres"
+	advertisementusecase "go-api/internal/features/advertisement/usecase"
+	candidatehandler "go-api/internal/features/candidate/delivery/http"
+	candidaterepo "go-api/internal/features/candidate/repository/postgres"
+	candidateusecase "go-api/internal/features/candidate/usecase"
+	categoryhandler "go-api/internal/features/category/delivery/http"
+	categoryrepo "go-api/internal/features/category/repository/postgres"
+	categoryusecase "go-api/internal/features/category/usecase"
+	fieldhandler "go-api/internal/features/field/delivery/http"
+	fieldrepo "go-api/internal/features/field/repository/postgres"
+	fieldusecase "go-api/internal/features/field/usecase"
+	invitationhandler "go-api/internal/features/invitation/delivery/http"
+	invitationrepo "go-api/internal/features/invitation/repository/postgres"
+	invitationusecase "go-api/internal/features/invitation/usecase"
+	profilehandler "go-api/internal/features/profile/delivery/http"
+	profilerepo "go-api/internal/features/profile/repository/postgres"
+	profileusecase "go-api/internal/features/profile/usecase"
+	refreshrepo "go-api/internal/features/refresh/repository/redisrepo"
+	reviewhandler "go-api/internal/features/review/delivery/http"
+	reviewrepo "go-api/internal/features/review/repository/postgres"
+	reviewusecase "go-api/internal/features/review/usecase"
 	sessionrepo "go-api/internal/features/session/repository/redisrepo"
 	sessionusecase "go-api/internal/features/session/usecase"
 	userhandler "go-api/internal/features/user/delivery/http"
 	userrepo "go-api/internal/features/user/repository/postgres"
 	userusecase "go-api/internal/features/user/usecase"
 	"go-api/internal/middleware"
+	"go-api/pkg/token"
 )
 
 func (s *Server) MapHandlers() error {
+	keys, err := token.NewKeySet(s.cfg)
+	if err != nil {
+		return err
+	}
+	if len(s.cfg.Server.JWTKeys) == 0 {
+		s.logger.Warn("No JWT keys configured, signing tokens with the shared JWTSecret (HS256)")
+	}
+
 	// Repository
 	userRepo := userrepo.NewUserRepository(s.db)
 	sessionRepo := sessionrepo.NewSessionRepository(s.redisClient, s.cfg)
+	refreshRepo := refreshrepo.NewRefreshRepository(s.redisClient, s.cfg)
+	categoryRepo := categoryrepo.NewCategoryRepository(s.db)
+	fieldRepo := fieldrepo.NewFieldRepository(s.db)
+	advertisementRepo := advertisementrepo.NewAdvertisementRepository(s.db)
+	candidateRepo := candidaterepo.NewCandidateRepository(s.db)
+	reviewRepo := reviewrepo.NewReviewRepository(s.db)
+	profileRepo := profilerepo.NewProfileRepository(s.db)
+	invitationRepo := invitationrepo.NewInvitationRepository(s.db)
+
+	// Publisher
+	advertisementPub := advertisementpub.NewAdvertisementPublisher(s.redisClient, s.cfg)
 
 	// UseCase
-	userUC := userusecase.NewUserUseCase(s.cfg, userRepo)
+	userUC := userusecase.NewUserUseCase(s.cfg, keys, userRepo, refreshRepo)
 	sessionUC := sessionusecase.NewSessionUseCase(sessionRepo, s.cfg)
+	categoryUC := categoryusecase.NewCategoryUseCase(s.cfg, categoryRepo)
+	fieldUC := fieldusecase.NewFieldUseCase(s.cfg, fieldRepo, categoryRepo)
+	advertisementUC := advertisementusecase.NewAdvertisementUseCase(s.cfg, advertisementRepo, categoryRepo, fieldRepo)
+	candidateUC := candidateusecase.NewCandidateUseCase(s.cfg, s.logger, candidateRepo, advertisementRepo, advertisementPub)
+	reviewUC := reviewusecase.NewReviewUseCase(s.cfg, reviewRepo, advertisementRepo)
+	profileUC := profileusecase.NewProfileUseCase(s.cfg, profileRepo, categoryRepo)
+	invitationUC := invitationusecase.NewInvitationUseCase(s.cfg, invitationRepo, advertisementRepo, userRepo, candidateRepo)
 
 	// Handler
 	userHandlers := userhandler.NewUserHandler(s.cfg, userUC, sessionUC)
+	categoryHandlers := categoryhandler.NewCategoryHandler(s.cfg, categoryUC)
+	fieldHandlers := fieldhandler.NewFieldHandler(s.cfg, fieldUC)
+	advertisementHandlers := advertisementhandler.NewAdvertisementHandler(s.cfg, advertisementUC)
+	candidateHandlers := candidatehandler.NewCandidateHandler(s.cfg, candidateUC)
+	reviewHandlers := reviewhandler.NewReviewHandler(s.cfg, reviewUC)
+	profileHandlers := profilehandler.NewProfileHandler(s.cfg, profileUC)
+	invitationHandlers := invitationhandler.NewInvitationHandler(s.cfg, invitationUC)
 
 	s.gin.NoRoute(func(c *gin.Context) {
 		c.JSON(404, gin.H{"code": "PAGE_NOT_FOUND", "message": "Page not found"})
 	})
 
-	mw := middleware.New(s.cfg, s.logger, userUC, sessionUC)
+	mw := middleware.New(s.cfg, keys, s.logger, userUC, sessionUC)
 
 	v1 := s.gin.Group("/v1")
 	v1.Use(mw.RequestID())
 
 	health := v1.Group("/health")
 	authGroup := v1.Group("/auth")
+	categoryGroup := v1.Group("/categories")
+	fieldGroup := categoryGroup.Group("/:category_id/fields")
+	advertisementGroup := v1.Group("/advertisements")
+	candidateGroup := advertisementGroup.Group("/:advertisement_id/candidates")
+	advertisementReviewGroup := advertisementGroup.Group("/:advertisement_id/reviews")
+	userReviewGroup := v1.Group("/users/:user_id/reviews")
+	workerGroup := v1.Group("/workers")
+	invitationGroup := v1.Group("/invitations")
 
 	userhandler.MapUserRoutes(authGroup, userHandlers, mw)
+	fieldhandler.MapFieldRoutes(fieldGroup, fieldHandlers, mw)
+	categoryhandler.MapCategoryRoutes(categoryGroup, categoryHandlers, mw)
+	candidatehandler.MapCandidateRoutes(candidateGroup, candidateHandlers, mw)
+	reviewhandler.MapReviewRoutes(advertisementReviewGroup, userReviewGroup, reviewHandlers, mw)
+	profilehandler.MapProfileRoutes(workerGroup, profileHandlers, mw)
+	invitationhandler.MapInvitationRoutes(invitationGroup, invitationHandlers, mw)
+	advertisementhandler.MapAdvertisementRoutes(advertisementGroup, advertisementHandlers, mw)
 
 	health.GET("", func(c *gin.Context) {
 		c.JSON(http.StatusOK, gin.H{
@@ -45,5 +117,10 @@ func (s *Server) MapHandlers() error {
 		})
 	})
 
+	s.gin.GET("/.well-known/jwks.json", func(c *gin.Context) {
+		c.Header("Cache-Control", "public, max-age=300")
+		c.JSON(http.StatusOK, keys.JWKS())
+	})
+
 	return nil
 }
@@ -36,8 +36,12 @@ type Server struct {
 
 // NewServer constructor
 func NewServer(cfg *config.Config, logger logger.Logger, db *sqlx.DB, redisClient *redis.Client) *Server {
+	engine := gin.Default()
+	// Let the usecases read the authenticated user from the gin context
+	engine.ContextWithFallback = true
+
 	return &Server{
-		gin:         gin.Default(),
+		gin:         engine,
 		cfg:         cfg,
 		logger:      logger,
 		db:          db,
@@ -50,6 +54,9 @@ func (s *Server) Run() error {
 		return err
 	}
 
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	workers := s.RunWorkers(workersCtx)
+
 	server := &http.Server{
 		Addr:           fmt.Sprintf("%s:%s", s.cfg.Server.Host, s.cfg.Server.Port),
 		ReadTimeout:    s.cfg.Server.ReadTimeout,
@@ -77,6 +84,9 @@ func (s *Server) Run() error {
 		log.Fatal("Server Shutdown:", err)
 	}
 
+	stopWorkers()
+	workers.Wait()
+
 	select {
 	case <-ctx.Done():
 		log.Println("timeout of 5 seconds.")
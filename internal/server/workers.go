@@ -0,0 +1,40 @@
+package server
+
+import (
+	"context"
+	"sync"
+
+	advertisementpub "go-api/internal/features/advertisement/publisher/redispub"
+	advertisementrepo "go-api/internal/features/advertisement/repository/postgres"
+	advertisementusecase "go-api/internal/features/advertisement/usecase"
+	advertisementworker "go-api/internal/features/advertisement/worker"
+	categoryrepo "go-api/internal/features/category/repository/postgres"
+	fieldrepo "go-api/internal/features/field/repository/postgres"
+)
+
+// RunWorkers starts the background workers. They stop when ctx is done and
+// the returned WaitGroup is released once all of them have returned.
+func (s *Server) RunWorkers(ctx context.Context) *sync.WaitGroup {
+	// Repository
+	advertisementRepo := advertisementrepo.NewAdvertisementRepository(s.db)
+	categoryRepo := categoryrepo.NewCategoryRepository(s.db)
+	fieldRepo := fieldrepo.NewFieldRepository(s.db)
+
+	// Publisher
+	advertisementPub := advertisementpub.NewAdvertisementPublisher(s.redisClient, s.cfg)
+
+	// UseCase
+	advertisementUC := advertisementusecase.NewAdvertisementUseCase(s.cfg, advertisementRepo, categoryRepo, fieldRepo)
+
+	// Worker
+	expirationWorker := advertisementworker.NewExpirationWorker(s.cfg, s.logger, advertisementUC, advertisementPub)
+
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		expirationWorker.Start(ctx)
+	}()
+
+	return wg
+}
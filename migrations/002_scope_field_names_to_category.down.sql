@@ -0,0 +1,5 @@
+ALTER TABLE fields DROP CONSTRAINT IF EXISTS fields_type_check;
+
+ALTER TABLE fields DROP CONSTRAINT IF EXISTS fields_category_id_name_key;
+
+ALTER TABLE fields ADD CONSTRAINT fields_name_key UNIQUE (name);
@@ -0,0 +1,7 @@
+ALTER TABLE fields DROP CONSTRAINT IF EXISTS fields_name_key;
+
+ALTER TABLE fields ADD CONSTRAINT fields_category_id_name_key UNIQUE (category_id, name);
+
+ALTER TABLE fields ADD CONSTRAINT fields_type_check CHECK (
+    type IN ('text', 'number', 'integer', 'boolean', 'date', 'single-select', 'multi-select', 'money')
+);
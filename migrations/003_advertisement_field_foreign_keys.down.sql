@@ -0,0 +1,3 @@
+ALTER TABLE advertisement_field DROP CONSTRAINT IF EXISTS advertisement_field_field_id_fkey;
+
+ALTER TABLE advertisement_field DROP CONSTRAINT IF EXISTS advertisement_field_advertisement_id_fkey;
@@ -0,0 +1,11 @@
+DELETE FROM advertisement_field af
+WHERE NOT EXISTS (SELECT 1 FROM advertisements a WHERE a.id = af.advertisement_id)
+    OR NOT EXISTS (SELECT 1 FROM fields f WHERE f.id = af.field_id);
+
+ALTER TABLE advertisement_field
+    ADD CONSTRAINT advertisement_field_advertisement_id_fkey
+    FOREIGN KEY (advertisement_id) REFERENCES advertisements(id) ON DELETE CASCADE;
+
+ALTER TABLE advertisement_field
+    ADD CONSTRAINT advertisement_field_field_id_fkey
+    FOREIGN KEY (field_id) REFERENCES fields(id) ON DELETE CASCADE;
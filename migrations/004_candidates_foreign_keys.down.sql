@@ -0,0 +1,3 @@
+ALTER TABLE candidates DROP CONSTRAINT IF EXISTS candidates_worker_id_fkey;
+
+ALTER TABLE candidates DROP CONSTRAINT IF EXISTS candidates_advertisement_id_fkey;
@@ -0,0 +1,11 @@
+DELETE FROM candidates c
+WHERE NOT EXISTS (SELECT 1 FROM advertisements a WHERE a.id = c.advertisement_id)
+    OR NOT EXISTS (SELECT 1 FROM users u WHERE u.id = c.worker_id);
+
+ALTER TABLE candidates
+    ADD CONSTRAINT candidates_advertisement_id_fkey
+    FOREIGN KEY (advertisement_id) REFERENCES advertisements(id) ON DELETE CASCADE;
+
+ALTER TABLE candidates
+    ADD CONSTRAINT candidates_worker_id_fkey
+    FOREIGN KEY (worker_id) REFERENCES users(id) ON DELETE CASCADE;
@@ -0,0 +1,3 @@
+ALTER TABLE candidates DROP COLUMN IF EXISTS status;
+
+DROP TYPE IF EXISTS CANDIDATESTATUS;
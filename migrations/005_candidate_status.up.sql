@@ -0,0 +1,8 @@
+CREATE TYPE CANDIDATESTATUS AS ENUM ('applied', 'selected', 'rejected');
+
+ALTER TABLE candidates ADD COLUMN status CANDIDATESTATUS NOT NULL DEFAULT 'applied';
+
+UPDATE candidates c
+SET status = CASE WHEN a.selected_cadidate = c.worker_id THEN 'selected' ELSE 'rejected' END::CANDIDATESTATUS
+FROM advertisements a
+WHERE a.id = c.advertisement_id AND a.selected_cadidate IS NOT NULL;
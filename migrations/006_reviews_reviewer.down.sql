@@ -0,0 +1,5 @@
+ALTER TABLE reviews DROP CONSTRAINT IF EXISTS reviews_advertisement_reviewer_unique;
+ALTER TABLE reviews DROP CONSTRAINT IF EXISTS reviews_rating_range;
+ALTER TABLE reviews ALTER COLUMN rating DROP NOT NULL;
+
+ALTER TABLE reviews DROP COLUMN IF EXISTS reviewer_id;
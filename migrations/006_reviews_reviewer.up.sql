@@ -0,0 +1,31 @@
+ALTER TABLE reviews ADD COLUMN reviewer_id UUID REFERENCES users(id) ON DELETE CASCADE;
+
+-- No code wrote reviews before this migration, so existing rows were
+-- inserted by hand and do not say who wrote them. They are attributed to the
+-- costumer of the advertisement, the only party every advertisement has.
+UPDATE reviews r
+SET reviewer_id = a.costumer_id
+FROM advertisements a
+WHERE a.id = r.advertisement_id;
+
+ALTER TABLE reviews ALTER COLUMN reviewer_id SET NOT NULL;
+
+-- A review without a rating cannot be counted, so those rows are dropped.
+-- Ratings out of range are clamped to the 1-5 scale.
+DELETE FROM reviews WHERE rating IS NULL;
+
+UPDATE reviews
+SET rating = LEAST(GREATEST(rating, 1), 5)
+WHERE rating NOT BETWEEN 1 AND 5;
+
+-- Each party reviews an advertisement once, so only the latest of the rows
+-- attributed to the same costumer is kept
+DELETE FROM reviews r
+USING reviews newer
+WHERE newer.advertisement_id = r.advertisement_id
+    AND newer.reviewer_id = r.reviewer_id
+    AND (newer.created_at, newer.id) > (r.created_at, r.id);
+
+ALTER TABLE reviews ALTER COLUMN rating SET NOT NULL;
+ALTER TABLE reviews ADD CONSTRAINT reviews_rating_range CHECK (rating BETWEEN 1 AND 5);
+ALTER TABLE reviews ADD CONSTRAINT reviews_advertisement_reviewer_unique UNIQUE (advertisement_id, reviewer_id);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS reviews_reviewee_direction_idx;
+
+ALTER TABLE reviews DROP CONSTRAINT IF EXISTS reviews_reviewer_not_reviewee;
+ALTER TABLE reviews DROP COLUMN IF EXISTS direction;
+ALTER TABLE reviews DROP COLUMN IF EXISTS reviewee_id;
+
+DROP TYPE IF EXISTS REVIEWDIRECTION;
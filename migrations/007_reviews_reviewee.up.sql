@@ -0,0 +1,26 @@
+CREATE TYPE REVIEWDIRECTION AS ENUM ('costumer_to_worker', 'worker_to_costumer');
+
+ALTER TABLE reviews ADD COLUMN reviewee_id UUID REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE reviews ADD COLUMN direction REVIEWDIRECTION;
+
+UPDATE reviews r
+SET reviewee_id = CASE WHEN r.reviewer_id = a.costumer_id THEN a.selected_cadidate ELSE a.costumer_id END,
+    direction = CASE WHEN r.reviewer_id = a.costumer_id THEN 'costumer_to_worker' ELSE 'worker_to_costumer' END::REVIEWDIRECTION
+FROM advertisements a
+WHERE a.id = r.advertisement_id;
+
+-- A review of an advertisement without a selected candidate has no one to
+-- rate, so the migration stops for those rows to be fixed by hand instead of
+-- dropping them
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM reviews WHERE reviewee_id IS NULL) THEN
+        RAISE EXCEPTION 'reviews without a reviewee must be fixed before migrating';
+    END IF;
+END $$;
+
+ALTER TABLE reviews ALTER COLUMN reviewee_id SET NOT NULL;
+ALTER TABLE reviews ALTER COLUMN direction SET NOT NULL;
+ALTER TABLE reviews ADD CONSTRAINT reviews_reviewer_not_reviewee CHECK (reviewer_id <> reviewee_id);
+
+CREATE INDEX reviews_reviewee_direction_idx ON reviews (reviewee_id, direction, created_at DESC);
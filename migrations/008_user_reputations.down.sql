@@ -0,0 +1,8 @@
+DROP TRIGGER IF EXISTS reviews_reputation ON reviews;
+
+DROP FUNCTION IF EXISTS reviews_reputation_trigger();
+DROP FUNCTION IF EXISTS apply_review_to_reputation(UUID, NUMERIC, INTEGER);
+DROP FUNCTION IF EXISTS rating_bucket(NUMERIC);
+DROP FUNCTION IF EXISTS reputation_score(NUMERIC, INTEGER);
+
+DROP TABLE IF EXISTS user_reputations;
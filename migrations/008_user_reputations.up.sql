@@ -0,0 +1,91 @@
+CREATE TABLE user_reputations (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    review_count INTEGER NOT NULL DEFAULT 0,
+    rating_sum NUMERIC NOT NULL DEFAULT 0,
+    rating_1 INTEGER NOT NULL DEFAULT 0,
+    rating_2 INTEGER NOT NULL DEFAULT 0,
+    rating_3 INTEGER NOT NULL DEFAULT 0,
+    rating_4 INTEGER NOT NULL DEFAULT 0,
+    rating_5 INTEGER NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Bayesian average: every user starts as if they had 5 reviews of 3 stars,
+-- so a single 5 star review does not outrank a long track record
+CREATE FUNCTION reputation_score(rating_sum NUMERIC, review_count INTEGER) RETURNS NUMERIC AS $$
+    SELECT ROUND((3 * 5 + rating_sum) / (5 + review_count), 4)
+$$ LANGUAGE SQL IMMUTABLE;
+
+CREATE FUNCTION rating_bucket(rating NUMERIC) RETURNS INTEGER AS $$
+    SELECT LEAST(5, GREATEST(1, ROUND(rating)))::INTEGER
+$$ LANGUAGE SQL IMMUTABLE;
+
+CREATE FUNCTION apply_review_to_reputation(p_user_id UUID, p_rating NUMERIC, p_sign INTEGER) RETURNS VOID AS $$
+BEGIN
+    -- Deleted reviews always have a row to subtract from; the user may be
+    -- going away in the same statement, so never insert for them
+    IF p_sign < 0 THEN
+        UPDATE user_reputations
+        SET review_count = review_count + p_sign,
+            rating_sum = rating_sum + p_sign * p_rating,
+            rating_1 = rating_1 + CASE WHEN rating_bucket(p_rating) = 1 THEN p_sign ELSE 0 END,
+            rating_2 = rating_2 + CASE WHEN rating_bucket(p_rating) = 2 THEN p_sign ELSE 0 END,
+            rating_3 = rating_3 + CASE WHEN rating_bucket(p_rating) = 3 THEN p_sign ELSE 0 END,
+            rating_4 = rating_4 + CASE WHEN rating_bucket(p_rating) = 4 THEN p_sign ELSE 0 END,
+            rating_5 = rating_5 + CASE WHEN rating_bucket(p_rating) = 5 THEN p_sign ELSE 0 END,
+            updated_at = CURRENT_TIMESTAMP
+        WHERE user_id = p_user_id;
+        RETURN;
+    END IF;
+
+    -- Upsert so concurrent first reviews of the same user do not race on
+    -- the insert
+    INSERT INTO user_reputations AS r (user_id, review_count, rating_sum, rating_1, rating_2, rating_3, rating_4, rating_5)
+    VALUES (
+        p_user_id, 1, p_rating,
+        (rating_bucket(p_rating) = 1)::INTEGER,
+        (rating_bucket(p_rating) = 2)::INTEGER,
+        (rating_bucket(p_rating) = 3)::INTEGER,
+        (rating_bucket(p_rating) = 4)::INTEGER,
+        (rating_bucket(p_rating) = 5)::INTEGER
+    )
+    ON CONFLICT (user_id) DO UPDATE
+    SET review_count = r.review_count + EXCLUDED.review_count,
+        rating_sum = r.rating_sum + EXCLUDED.rating_sum,
+        rating_1 = r.rating_1 + EXCLUDED.rating_1,
+        rating_2 = r.rating_2 + EXCLUDED.rating_2,
+        rating_3 = r.rating_3 + EXCLUDED.rating_3,
+        rating_4 = r.rating_4 + EXCLUDED.rating_4,
+        rating_5 = r.rating_5 + EXCLUDED.rating_5,
+        updated_at = CURRENT_TIMESTAMP;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION reviews_reputation_trigger() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP IN ('DELETE', 'UPDATE') THEN
+        PERFORM apply_review_to_reputation(OLD.reviewee_id, OLD.rating, -1);
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        PERFORM apply_review_to_reputation(NEW.reviewee_id, NEW.rating, 1);
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER reviews_reputation
+AFTER INSERT OR DELETE OR UPDATE OF rating, reviewee_id ON reviews
+FOR EACH ROW EXECUTE FUNCTION reviews_reputation_trigger();
+
+INSERT INTO user_reputations (user_id, review_count, rating_sum, rating_1, rating_2, rating_3, rating_4, rating_5)
+SELECT reviewee_id,
+    COUNT(*),
+    SUM(rating),
+    COUNT(*) FILTER (WHERE rating_bucket(rating) = 1),
+    COUNT(*) FILTER (WHERE rating_bucket(rating) = 2),
+    COUNT(*) FILTER (WHERE rating_bucket(rating) = 3),
+    COUNT(*) FILTER (WHERE rating_bucket(rating) = 4),
+    COUNT(*) FILTER (WHERE rating_bucket(rating) = 5)
+FROM reviews
+GROUP BY reviewee_id;
@@ -0,0 +1,6 @@
+ALTER TABLE advertisements ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('portuguese', COALESCE(title, '')), 'A') ||
+    setweight(to_tsvector('portuguese', COALESCE(description, '')), 'B')
+) STORED;
+
+CREATE INDEX advertisements_search_vector_idx ON advertisements USING GIN (search_vector);
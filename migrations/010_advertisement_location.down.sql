@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS advertisements_coordinates_idx;
+
+ALTER TABLE advertisements DROP CONSTRAINT IF EXISTS advertisements_location_coordinates;
+ALTER TABLE advertisements DROP COLUMN IF EXISTS longitude;
+ALTER TABLE advertisements DROP COLUMN IF EXISTS latitude;
+ALTER TABLE advertisements DROP COLUMN IF EXISTS location;
+
+DROP FUNCTION IF EXISTS earth_distance_km(DOUBLE PRECISION, DOUBLE PRECISION, DOUBLE PRECISION, DOUBLE PRECISION);
@@ -0,0 +1,23 @@
+-- Great-circle distance with the haversine formula, so radius searches do not
+-- need the cube/earthdistance or PostGIS extensions. The radius matches
+-- geo.EarthRadiusKm.
+CREATE FUNCTION earth_distance_km(lat1 DOUBLE PRECISION, lng1 DOUBLE PRECISION, lat2 DOUBLE PRECISION, lng2 DOUBLE PRECISION)
+RETURNS DOUBLE PRECISION AS $$
+    SELECT 2 * 6371.0 * ASIN(LEAST(1, SQRT(
+        POWER(SIN(RADIANS(lat2 - lat1) / 2), 2) +
+        COS(RADIANS(lat1)) * COS(RADIANS(lat2)) * POWER(SIN(RADIANS(lng2 - lng1) / 2), 2)
+    )))
+$$ LANGUAGE SQL IMMUTABLE;
+
+ALTER TABLE advertisements ADD COLUMN location JSONB;
+
+ALTER TABLE advertisements ADD COLUMN latitude DOUBLE PRECISION
+    GENERATED ALWAYS AS ((location->>'latitude')::DOUBLE PRECISION) STORED;
+ALTER TABLE advertisements ADD COLUMN longitude DOUBLE PRECISION
+    GENERATED ALWAYS AS ((location->>'longitude')::DOUBLE PRECISION) STORED;
+
+ALTER TABLE advertisements ADD CONSTRAINT advertisements_location_coordinates CHECK (
+    location IS NULL OR (latitude BETWEEN -90 AND 90 AND longitude BETWEEN -180 AND 180)
+);
+
+CREATE INDEX advertisements_coordinates_idx ON advertisements (latitude, longitude) WHERE location IS NOT NULL;
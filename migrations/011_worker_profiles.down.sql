@@ -0,0 +1,4 @@
+DROP FUNCTION IF EXISTS worker_profile_public(UUID);
+
+DROP TABLE IF EXISTS worker_categories;
+DROP TABLE IF EXISTS worker_profiles;
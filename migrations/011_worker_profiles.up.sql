@@ -0,0 +1,53 @@
+CREATE TABLE worker_profiles (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    display_name VARCHAR(80) NOT NULL CHECK (display_name <> ''),
+    bio TEXT NOT NULL DEFAULT '',
+    skills JSONB NOT NULL DEFAULT '[]',
+    hourly_rate BIGINT CHECK (hourly_rate >= 0),
+    currency CHAR(3),
+    service_area JSONB,
+    latitude DOUBLE PRECISION GENERATED ALWAYS AS ((service_area->>'latitude')::DOUBLE PRECISION) STORED,
+    longitude DOUBLE PRECISION GENERATED ALWAYS AS ((service_area->>'longitude')::DOUBLE PRECISION) STORED,
+    radius_km DOUBLE PRECISION GENERATED ALWAYS AS ((service_area->>'radius_km')::DOUBLE PRECISION) STORED,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT worker_profiles_rate_currency CHECK ((hourly_rate IS NULL) = (currency IS NULL)),
+    CONSTRAINT worker_profiles_service_area CHECK (
+        service_area IS NULL OR (latitude BETWEEN -90 AND 90 AND longitude BETWEEN -180 AND 180 AND radius_km > 0)
+    )
+);
+
+CREATE INDEX worker_profiles_coordinates_idx ON worker_profiles (latitude, longitude) WHERE service_area IS NOT NULL;
+
+CREATE TABLE worker_categories (
+    user_id UUID NOT NULL REFERENCES worker_profiles(user_id) ON DELETE CASCADE,
+    category_id UUID NOT NULL REFERENCES categories(id) ON DELETE CASCADE,
+    PRIMARY KEY (user_id, category_id)
+);
+
+CREATE INDEX worker_categories_category_idx ON worker_categories (category_id);
+
+-- Public part of a worker profile, embedded wherever workers are listed.
+-- The service area center stays private, only its city and reach are shown.
+CREATE FUNCTION worker_profile_public(p_user_id UUID) RETURNS JSON AS $$
+    SELECT json_build_object(
+        'user_id', p.user_id,
+        'display_name', p.display_name,
+        'bio', p.bio,
+        'skills', p.skills,
+        'categories', COALESCE(
+            (SELECT json_agg(wc.category_id ORDER BY wc.category_id) FROM worker_categories wc WHERE wc.user_id = p.user_id),
+            '[]'::JSON
+        ),
+        'hourly_rate', p.hourly_rate,
+        'currency', p.currency,
+        'service_area', CASE WHEN p.service_area IS NOT NULL THEN json_build_object(
+            'city', p.service_area->>'city',
+            'state', p.service_area->>'state',
+            'country', p.service_area->>'country',
+            'radius_km', p.radius_km
+        ) END
+    )
+    FROM worker_profiles p
+    WHERE p.user_id = p_user_id
+$$ LANGUAGE SQL STABLE;
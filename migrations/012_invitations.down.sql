@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS advertisements_expire_invitations ON advertisements;
+DROP FUNCTION IF EXISTS advertisements_invitations_trigger();
+
+DROP TABLE IF EXISTS invitations;
+
+DROP TYPE IF EXISTS INVITATIONSTATUS;
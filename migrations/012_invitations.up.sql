@@ -0,0 +1,37 @@
+CREATE TYPE INVITATIONSTATUS AS ENUM ('pending', 'accepted', 'declined', 'expired');
+
+CREATE TABLE invitations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    advertisement_id UUID NOT NULL REFERENCES advertisements(id) ON DELETE CASCADE,
+    costumer_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    worker_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status INVITATIONSTATUS NOT NULL DEFAULT 'pending',
+    message TEXT NOT NULL DEFAULT '',
+    responded_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (advertisement_id, worker_id),
+    CONSTRAINT invitations_distinct_parties CHECK (costumer_id <> worker_id)
+);
+
+CREATE INDEX invitations_costumer_idx ON invitations (costumer_id, created_at DESC);
+CREATE INDEX invitations_worker_idx ON invitations (worker_id, created_at DESC);
+
+-- Pending invitations can no longer be accepted once the advertisement
+-- leaves opened or gets a selected candidate
+CREATE FUNCTION advertisements_invitations_trigger() RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE invitations
+    SET status = 'expired',
+        updated_at = CURRENT_TIMESTAMP
+    WHERE advertisement_id = NEW.id AND status = 'pending';
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER advertisements_expire_invitations
+AFTER UPDATE OF status, selected_cadidate ON advertisements
+FOR EACH ROW
+WHEN (NEW.status <> 'opened' OR NEW.selected_cadidate IS NOT NULL)
+EXECUTE FUNCTION advertisements_invitations_trigger();
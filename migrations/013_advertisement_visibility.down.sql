@@ -0,0 +1,5 @@
+ALTER TABLE advertisements DROP CONSTRAINT IF EXISTS advertisements_share_token;
+ALTER TABLE advertisements DROP COLUMN IF EXISTS share_token;
+ALTER TABLE advertisements DROP COLUMN IF EXISTS visibility;
+
+DROP TYPE IF EXISTS ADVISIBILITY;
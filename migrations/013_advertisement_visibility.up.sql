@@ -0,0 +1,9 @@
+CREATE TYPE ADVISIBILITY AS ENUM ('public', 'unlisted', 'invite_only');
+
+ALTER TABLE advertisements ADD COLUMN visibility ADVISIBILITY NOT NULL DEFAULT 'public';
+ALTER TABLE advertisements ADD COLUMN share_token VARCHAR(64) UNIQUE;
+
+-- Only unlisted advertisements are reachable by link
+ALTER TABLE advertisements ADD CONSTRAINT advertisements_share_token CHECK (
+    (visibility = 'unlisted') = (share_token IS NOT NULL)
+);
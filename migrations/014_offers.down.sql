@@ -0,0 +1,5 @@
+ALTER TABLE advertisements DROP COLUMN IF EXISTS accepted_price;
+
+DROP TABLE IF EXISTS offers;
+
+DROP TYPE IF EXISTS OFFERSTATUS;
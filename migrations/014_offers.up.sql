@@ -0,0 +1,25 @@
+CREATE TYPE OFFERSTATUS AS ENUM ('pending', 'accepted', 'countered');
+
+-- Price negotiation between the costumer and a candidate. The bid sent when
+-- applying opens the thread and every counter-offer replaces the pending one.
+CREATE TABLE offers (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    advertisement_id UUID NOT NULL,
+    worker_id UUID NOT NULL,
+    author_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    amount BIGINT NOT NULL CHECK (amount >= 0),
+    currency CHAR(3) NOT NULL,
+    message TEXT NOT NULL DEFAULT '',
+    status OFFERSTATUS NOT NULL DEFAULT 'pending',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (advertisement_id, worker_id)
+        REFERENCES candidates(advertisement_id, worker_id) ON DELETE CASCADE
+);
+
+CREATE INDEX offers_candidate_idx ON offers (advertisement_id, worker_id, created_at DESC);
+
+-- Only one offer of a thread waits for an answer at a time
+CREATE UNIQUE INDEX offers_pending_idx ON offers (advertisement_id, worker_id) WHERE status = 'pending';
+
+ALTER TABLE advertisements ADD COLUMN accepted_price BIGINT CHECK (accepted_price >= 0);
@@ -18,6 +18,7 @@ type APIError struct {
 	HTTPStatus int    `json:"-"`
 	ErrCode    string `json:"code"`
 	Message    string `json:"message"`
+	Details    any    `json:"details,omitempty"`
 }
 
 // NewAPIError creates a new instance of APIError.
@@ -40,6 +41,12 @@ func (e *APIError) Code(code string) {
 	e.ErrCode = code
 }
 
+// WithDetails attaches structured data describing the error.
+func (e *APIError) WithDetails(details any) *APIError {
+	e.Details = details
+	return e
+}
+
 // BadRequest creates a 400 Bad Request error.
 func BadRequest(messages ...string) *APIError {
 	return NewAPIError(http.StatusBadRequest, "", strings.Join(messages, separator))
@@ -97,10 +104,15 @@ func Parse(err error) *APIError {
 
 // JSON represents the error in JSON format.
 func (e *APIError) JSON() (int, map[string]interface{}) {
-	return e.HTTPStatus, map[string]interface{}{
+	body := map[string]interface{}{
 		"code":    e.ErrCode,
 		"message": e.Message,
 	}
+	if e.Details != nil {
+		body["details"] = e.Details
+	}
+
+	return e.HTTPStatus, body
 }
 
 // JSON represents the error in JSON format.
@@ -22,6 +22,22 @@ type Server struct {
 	ReadTimeout  time.Duration
 	WriteTimeout time.Duration
 	JWTSecret    string
+	JWTIssuer    string
+	JWTAudience  string
+	// JWTKeys replace JWTSecret when set. The first key able to sign signs
+	// every token, so new keys go first.
+	JWTKeys []JWTKey
+	// JWTRotationWindow is how long retired keys keep verifying, the access
+	// token lifetime when unset
+	JWTRotationWindow time.Duration
+}
+
+// JWTKey config, an RSA or Ed25519 key in a PEM file identified by its kid.
+// Private keys sign until RetiredAt and public keys only verify.
+type JWTKey struct {
+	Kid       string
+	File      string
+	RetiredAt time.Time
 }
 
 // Session config
@@ -62,14 +78,36 @@ type Redis struct {
 	DB           int
 }
 
+// Events config
+type Events struct {
+	Channel string
+}
+
+// Expiration worker config
+type Expiration struct {
+	Interval  time.Duration
+	BatchSize int
+}
+
+// Auth config, the lifetimes of the tokens issued on register, login and
+// refresh
+type Auth struct {
+	AccessTokenDuration  time.Duration
+	RefreshTokenDuration time.Duration
+	RefreshPrefix        string
+}
+
 // Config centralizer
 type Config struct {
-	Logger   Logger
-	Server   Server
-	Session  Session
-	Cookie   Cookie
-	Postgres Postgres
-	Redis    Redis
+	Logger     Logger
+	Server     Server
+	Session    Session
+	Auth       Auth
+	Cookie     Cookie
+	Postgres   Postgres
+	Redis      Redis
+	Events     Events
+	Expiration Expiration
 }
 
 func LoadConfig(fileName string, filePath string) (*viper.Viper, error) {
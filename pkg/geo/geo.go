@@ -0,0 +1,85 @@
+package geo
+
+import (
+	"errors"
+	"math"
+)
+
+// EarthRadiusKm is the mean earth radius used by the distance calculations.
+// It must match the earth_distance_km SQL function.
+const EarthRadiusKm = 6371.0
+
+// Point is a WGS 84 coordinate in decimal degrees
+type Point struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// Validate checks the coordinate ranges
+func (p Point) Validate() error {
+	if math.IsNaN(p.Latitude) || p.Latitude < -90 || p.Latitude > 90 {
+		return errors.New("latitude must be between -90 and 90")
+	}
+
+	if math.IsNaN(p.Longitude) || p.Longitude < -180 || p.Longitude > 180 {
+		return errors.New("longitude must be between -180 and 180")
+	}
+
+	return nil
+}
+
+// DistanceKm returns the great-circle distance between two points using the
+// haversine formula
+func DistanceKm(a, b Point) float64 {
+	lat1, lat2 := radians(a.Latitude), radians(b.Latitude)
+	dLat := lat2 - lat1
+	dLng := radians(b.Longitude - a.Longitude)
+
+	h := math.Pow(math.Sin(dLat/2), 2) + math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin(dLng/2), 2)
+
+	return 2 * EarthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// Box is a latitude/longitude range
+type Box struct {
+	MinLatitude  float64
+	MaxLatitude  float64
+	MinLongitude float64
+	MaxLongitude float64
+}
+
+// BoundingBox returns a box containing every point within radiusKm of the
+// center. It lets queries discard far away rows with plain range conditions
+// before computing exact distances. Near the poles or across the
+// antimeridian it falls back to the whole longitude range.
+func BoundingBox(center Point, radiusKm float64) Box {
+	dLat := degrees(radiusKm / EarthRadiusKm)
+	box := Box{
+		MinLatitude:  math.Max(-90, center.Latitude-dLat),
+		MaxLatitude:  math.Min(90, center.Latitude+dLat),
+		MinLongitude: -180,
+		MaxLongitude: 180,
+	}
+
+	if box.MinLatitude == -90 || box.MaxLatitude == 90 {
+		return box
+	}
+
+	dLng := degrees(math.Asin(math.Min(1, math.Sin(radiusKm/EarthRadiusKm)/math.Cos(radians(center.Latitude)))))
+	if center.Longitude-dLng < -180 || center.Longitude+dLng > 180 {
+		return box
+	}
+
+	box.MinLongitude = center.Longitude - dLng
+	box.MaxLongitude = center.Longitude + dLng
+
+	return box
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func degrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
@@ -0,0 +1,46 @@
+package geo_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go-api/pkg/geo"
+)
+
+var (
+	saoPaulo = geo.Point{Latitude: -23.5505, Longitude: -46.6333}
+	rio      = geo.Point{Latitude: -22.9068, Longitude: -43.1729}
+)
+
+func TestPoint_Validate(t *testing.T) {
+	assert.NoError(t, saoPaulo.Validate())
+	assert.Error(t, geo.Point{Latitude: 91}.Validate())
+	assert.Error(t, geo.Point{Longitude: -180.5}.Validate())
+}
+
+func TestDistanceKm(t *testing.T) {
+	t.Run("Success between cities", func(t *testing.T) {
+		assert.InDelta(t, 361, geo.DistanceKm(saoPaulo, rio), 1)
+	})
+
+	t.Run("Success with same point", func(t *testing.T) {
+		assert.Zero(t, geo.DistanceKm(rio, rio))
+	})
+}
+
+func TestBoundingBox(t *testing.T) {
+	t.Run("Success containing the radius", func(t *testing.T) {
+		box := geo.BoundingBox(saoPaulo, 400)
+
+		assert.True(t, rio.Latitude > box.MinLatitude && rio.Latitude < box.MaxLatitude)
+		assert.True(t, rio.Longitude > box.MinLongitude && rio.Longitude < box.MaxLongitude)
+	})
+
+	t.Run("Success across the antimeridian", func(t *testing.T) {
+		box := geo.BoundingBox(geo.Point{Latitude: -17.7, Longitude: 179.9}, 50)
+
+		assert.Equal(t, float64(-180), box.MinLongitude)
+		assert.Equal(t, float64(180), box.MaxLongitude)
+	})
+}
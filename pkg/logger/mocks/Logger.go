@@ -0,0 +1,77 @@
+// Code generated by mockery v2.20.0. DO NOT EDIT.
+
+package loggermock
+
+import (
+	logger "go-api/pkg/logger"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Logger is an autogenerated mock type for the Logger type
+type Logger struct {
+	mock.Mock
+}
+
+// Debug provides a mock function with given fields: message, fields
+func (_m *Logger) Debug(message string, fields ...logger.Fields) {
+	_va := make([]interface{}, len(fields))
+	for _i := range fields {
+		_va[_i] = fields[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, message)
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
+}
+
+// Error provides a mock function with given fields: message, fields
+func (_m *Logger) Error(message string, fields ...logger.Fields) {
+	_va := make([]interface{}, len(fields))
+	for _i := range fields {
+		_va[_i] = fields[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, message)
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
+}
+
+// Info provides a mock function with given fields: message, fields
+func (_m *Logger) Info(message string, fields ...logger.Fields) {
+	_va := make([]interface{}, len(fields))
+	for _i := range fields {
+		_va[_i] = fields[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, message)
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
+}
+
+// Warn provides a mock function with given fields: message, fields
+func (_m *Logger) Warn(message string, fields ...logger.Fields) {
+	_va := make([]interface{}, len(fields))
+	for _i := range fields {
+		_va[_i] = fields[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, message)
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
+}
+
+type mockConstructorTestingTNewLogger interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewLogger creates a new instance of Logger. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewLogger(t mockConstructorTestingTNewLogger) *Logger {
+	mock := &Logger{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,209 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrUnknownCurrency  = errors.New("unknown currency")
+	ErrCurrencyMismatch = errors.New("currencies do not match")
+	ErrOverflow         = errors.New("amount out of range")
+	ErrInvalidAmount    = errors.New("invalid amount")
+)
+
+// Money is an amount in the minor unit of an ISO 4217 currency, such as
+// cents for BRL. It is marshalled to JSON in both forms, for example
+// {"amount": 15050, "currency": "BRL", "value": "150.50"}, and read from
+// either of them.
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+// New returns the amount of minor units of the currency
+func New(amount int64, currency string) (Money, error) {
+	c, ok := LookupCurrency(currency)
+	if !ok {
+		return Money{}, ErrUnknownCurrency
+	}
+
+	return Money{Amount: amount, Currency: c.Code}, nil
+}
+
+// Parse reads a decimal amount in major units, such as "150.50", which may
+// not have more decimal places than the currency minor unit
+func Parse(value, currency string) (Money, error) {
+	c, ok := LookupCurrency(currency)
+	if !ok {
+		return Money{}, ErrUnknownCurrency
+	}
+
+	s := strings.TrimSpace(value)
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+
+	whole, frac, hasFrac := strings.Cut(s, ".")
+	if whole == "" || (hasFrac && frac == "") || len(frac) > c.Decimals || !digits(whole) || !digits(frac) {
+		return Money{}, ErrInvalidAmount
+	}
+
+	minor := whole + frac + strings.Repeat("0", c.Decimals-len(frac))
+	amount, err := strconv.ParseInt(minor, 10, 64)
+	if err != nil {
+		return Money{}, ErrOverflow
+	}
+	if negative {
+		amount = -amount
+	}
+
+	return Money{Amount: amount, Currency: c.Code}, nil
+}
+
+// Validate checks the currency is an ISO 4217 one
+func (m Money) Validate() error {
+	if !IsValidCurrency(m.Currency) {
+		return ErrUnknownCurrency
+	}
+
+	return nil
+}
+
+// Add returns the sum of two amounts of the same currency
+func (m Money) Add(o Money) (Money, error) {
+	if m.Currency != o.Currency {
+		return Money{}, ErrCurrencyMismatch
+	}
+
+	sum := m.Amount + o.Amount
+	if (o.Amount > 0 && sum < m.Amount) || (o.Amount < 0 && sum > m.Amount) {
+		return Money{}, ErrOverflow
+	}
+
+	return Money{Amount: sum, Currency: m.Currency}, nil
+}
+
+// Sub returns the difference of two amounts of the same currency
+func (m Money) Sub(o Money) (Money, error) {
+	if o.Amount == math.MinInt64 {
+		return Money{}, ErrOverflow
+	}
+
+	return m.Add(Money{Amount: -o.Amount, Currency: o.Currency})
+}
+
+// Cmp compares two amounts of the same currency and returns -1, 0 or +1
+func (m Money) Cmp(o Money) (int, error) {
+	if m.Currency != o.Currency {
+		return 0, ErrCurrencyMismatch
+	}
+
+	switch {
+	case m.Amount < o.Amount:
+		return -1, nil
+	case m.Amount > o.Amount:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.Amount < 0
+}
+
+// Decimal returns the amount in major units with the currency decimal
+// places, such as "150.50"
+func (m Money) Decimal() string {
+	decimals := currencies[m.Currency]
+
+	sign := ""
+	amount := uint64(m.Amount)
+	if m.Amount < 0 {
+		sign = "-"
+		amount = uint64(-(m.Amount + 1)) + 1
+	}
+
+	s := strconv.FormatUint(amount, 10)
+	if decimals == 0 {
+		return sign + s
+	}
+
+	if len(s) <= decimals {
+		s = strings.Repeat("0", decimals-len(s)+1) + s
+	}
+
+	return sign + s[:len(s)-decimals] + "." + s[len(s)-decimals:]
+}
+
+func (m Money) String() string {
+	return fmt.Sprintf("%s %s", m.Decimal(), m.Currency)
+}
+
+type moneyJSON struct {
+	Amount   *int64  `json:"amount,omitempty"`
+	Currency string  `json:"currency"`
+	Value    *string `json:"value,omitempty"`
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	value := m.Decimal()
+
+	return json.Marshal(moneyJSON{
+		Amount:   &m.Amount,
+		Currency: m.Currency,
+		Value:    &value,
+	})
+}
+
+// UnmarshalJSON reads the amount in minor units from amount or in major
+// units from value. When both are sent they must be the same amount.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var v moneyJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	c, ok := LookupCurrency(v.Currency)
+	if !ok {
+		return ErrUnknownCurrency
+	}
+
+	var parsed Money
+	switch {
+	case v.Value != nil:
+		p, err := Parse(*v.Value, c.Code)
+		if err != nil {
+			return err
+		}
+		if v.Amount != nil && *v.Amount != p.Amount {
+			return fmt.Errorf("%w: amount and value differ", ErrInvalidAmount)
+		}
+		parsed = p
+	case v.Amount != nil:
+		parsed = Money{Amount: *v.Amount, Currency: c.Code}
+	default:
+		return fmt.Errorf("%w: amount or value is required", ErrInvalidAmount)
+	}
+
+	*m = parsed
+	return nil
+}
+
+func digits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,155 @@
+package money_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go-api/pkg/money"
+)
+
+func TestLookupCurrency(t *testing.T) {
+	c, ok := money.LookupCurrency("brl")
+	assert.True(t, ok)
+	assert.Equal(t, money.Currency{Code: "BRL", Decimals: 2}, c)
+
+	c, ok = money.LookupCurrency("KWD")
+	assert.True(t, ok)
+	assert.Equal(t, 3, c.Decimals)
+
+	_, ok = money.LookupCurrency("XYZ")
+	assert.False(t, ok)
+
+	assert.False(t, money.IsValidCurrency("brl"))
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		value    string
+		currency string
+		want     money.Money
+		err      error
+	}{
+		{value: "150.50", currency: "BRL", want: money.Money{Amount: 15050, Currency: "BRL"}},
+		{value: "150.5", currency: "brl", want: money.Money{Amount: 15050, Currency: "BRL"}},
+		{value: "150", currency: "BRL", want: money.Money{Amount: 15000, Currency: "BRL"}},
+		{value: "-0.05", currency: "USD", want: money.Money{Amount: -5, Currency: "USD"}},
+		{value: "1500", currency: "JPY", want: money.Money{Amount: 1500, Currency: "JPY"}},
+		{value: "1.234", currency: "KWD", want: money.Money{Amount: 1234, Currency: "KWD"}},
+		{value: "1.5", currency: "JPY", err: money.ErrInvalidAmount},
+		{value: "1.505", currency: "BRL", err: money.ErrInvalidAmount},
+		{value: "1,50", currency: "BRL", err: money.ErrInvalidAmount},
+		{value: "1.", currency: "BRL", err: money.ErrInvalidAmount},
+		{value: "", currency: "BRL", err: money.ErrInvalidAmount},
+		{value: "99999999999999999999", currency: "BRL", err: money.ErrOverflow},
+		{value: "1", currency: "XYZ", err: money.ErrUnknownCurrency},
+	}
+
+	for _, tt := range tests {
+		got, err := money.Parse(tt.value, tt.currency)
+		if tt.err != nil {
+			assert.ErrorIs(t, err, tt.err, tt.value)
+			continue
+		}
+		assert.NoError(t, err, tt.value)
+		assert.Equal(t, tt.want, got, tt.value)
+	}
+}
+
+func TestMoney_Decimal(t *testing.T) {
+	tests := []struct {
+		money money.Money
+		want  string
+	}{
+		{money: money.Money{Amount: 15050, Currency: "BRL"}, want: "150.50"},
+		{money: money.Money{Amount: 5, Currency: "BRL"}, want: "0.05"},
+		{money: money.Money{Amount: -5, Currency: "BRL"}, want: "-0.05"},
+		{money: money.Money{Amount: 1500, Currency: "JPY"}, want: "1500"},
+		{money: money.Money{Amount: 1, Currency: "KWD"}, want: "0.001"},
+		{money: money.Money{Amount: math.MinInt64, Currency: "JPY"}, want: "-9223372036854775808"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tt.money.Decimal())
+	}
+
+	assert.Equal(t, "150.50 BRL", money.Money{Amount: 15050, Currency: "BRL"}.String())
+}
+
+func TestMoney_Arithmetic(t *testing.T) {
+	brl := money.Money{Amount: 15000, Currency: "BRL"}
+
+	t.Run("Success with same currency", func(t *testing.T) {
+		sum, err := brl.Add(money.Money{Amount: 50, Currency: "BRL"})
+		assert.NoError(t, err)
+		assert.Equal(t, money.Money{Amount: 15050, Currency: "BRL"}, sum)
+
+		diff, err := brl.Sub(money.Money{Amount: 20000, Currency: "BRL"})
+		assert.NoError(t, err)
+		assert.True(t, diff.IsNegative())
+
+		cmp, err := brl.Cmp(sum)
+		assert.NoError(t, err)
+		assert.Equal(t, -1, cmp)
+	})
+
+	t.Run("Fail with mixed currencies", func(t *testing.T) {
+		usd := money.Money{Amount: 15000, Currency: "USD"}
+
+		_, err := brl.Add(usd)
+		assert.ErrorIs(t, err, money.ErrCurrencyMismatch)
+
+		_, err = brl.Sub(usd)
+		assert.ErrorIs(t, err, money.ErrCurrencyMismatch)
+
+		_, err = brl.Cmp(usd)
+		assert.ErrorIs(t, err, money.ErrCurrencyMismatch)
+	})
+
+	t.Run("Fail with overflow", func(t *testing.T) {
+		_, err := money.Money{Amount: math.MaxInt64, Currency: "BRL"}.Add(money.Money{Amount: 1, Currency: "BRL"})
+		assert.ErrorIs(t, err, money.ErrOverflow)
+
+		_, err = money.Money{Amount: 0, Currency: "BRL"}.Sub(money.Money{Amount: math.MinInt64, Currency: "BRL"})
+		assert.ErrorIs(t, err, money.ErrOverflow)
+	})
+}
+
+func TestMoney_JSON(t *testing.T) {
+	t.Run("Success marshalling both forms", func(t *testing.T) {
+		data, err := json.Marshal(money.Money{Amount: 15050, Currency: "BRL"})
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"amount": 15050, "currency": "BRL", "value": "150.50"}`, string(data))
+	})
+
+	t.Run("Success unmarshalling either form", func(t *testing.T) {
+		inputs := []string{
+			`{"amount": 15050, "currency": "BRL"}`,
+			`{"value": "150.50", "currency": "brl"}`,
+			`{"amount": 15050, "value": "150.5", "currency": "BRL"}`,
+		}
+
+		for _, input := range inputs {
+			var m money.Money
+			assert.NoError(t, json.Unmarshal([]byte(input), &m), input)
+			assert.Equal(t, money.Money{Amount: 15050, Currency: "BRL"}, m, input)
+		}
+	})
+
+	t.Run("Fail with invalid input", func(t *testing.T) {
+		inputs := []string{
+			`{"amount": 15050}`,
+			`{"amount": 15050, "currency": "XYZ"}`,
+			`{"currency": "BRL"}`,
+			`{"amount": 15000, "value": "150.50", "currency": "BRL"}`,
+			`{"value": "150.505", "currency": "BRL"}`,
+		}
+
+		for _, input := range inputs {
+			var m money.Money
+			assert.Error(t, json.Unmarshal([]byte(input), &m), input)
+		}
+	})
+}
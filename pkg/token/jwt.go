@@ -7,14 +7,17 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt"
-
-	"go-api/pkg/config"
 )
 
 // errors
 var (
+	ErrMissingJWT          = errors.New("missing jwt")
 	ErrInvalidJWT          = errors.New("invalid jwt")
 	ErrInvalidJWTSignature = errors.New("invalid jwt signature")
+	ErrExpiredJWT          = errors.New("expired jwt")
+	ErrNoSigningKey        = errors.New("no jwt key can sign")
+	ErrUnknownKey          = errors.New("unknown jwt key")
+	ErrMissingClaimsConfig = errors.New("jwt issuer and audience are required")
 )
 
 type Claims struct {
@@ -23,19 +26,34 @@ type Claims struct {
 	jwt.StandardClaims
 }
 
-// GenerateJWT generate a new token with claims
-func GenerateJWT(email, id string, duration time.Duration, cfg *config.Config) (string, error) {
+// GenerateJWT generate a new token with claims, signed by the current key
+func (ks *KeySet) GenerateJWT(email, id string, duration time.Duration) (string, error) {
+	now := time.Now()
 	claims := Claims{
 		Email: email,
 		ID:    id,
 		StandardClaims: jwt.StandardClaims{
-			ExpiresAt: time.Now().Add(duration).Unix(),
+			Audience:  ks.cfg.Server.JWTAudience,
+			Issuer:    ks.cfg.Server.JWTIssuer,
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(duration).Unix(),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	if len(ks.keys) == 0 {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		return token.SignedString([]byte(ks.cfg.Server.JWTSecret))
+	}
+
+	key, err := ks.signingKey(now)
+	if err != nil {
+		return "", err
+	}
+
+	token := jwt.NewWithClaims(key.Method, claims)
+	token.Header["kid"] = key.ID
 
-	tokenStr, err := token.SignedString([]byte(cfg.Server.JWTSecret))
+	tokenStr, err := token.SignedString(key.Private)
 	if err != nil {
 		return "", err
 	}
@@ -43,26 +61,50 @@ func GenerateJWT(email, id string, duration time.Duration, cfg *config.Config) (
 	return tokenStr, nil
 }
 
-// ExtractJWT from the request
-func ExtractJWT(r *http.Request) (jwt.MapClaims, error) {
+// ExtractJWT parses and validates the bearer token of the request
+func (ks *KeySet) ExtractJWT(r *http.Request) (*Claims, error) {
 	tokenStr := extractBearerToken(r)
+	if tokenStr == "" {
+		return nil, ErrMissingJWT
+	}
 
-	claims := jwt.MapClaims{}
+	return ks.ParseJWT(tokenStr)
+}
+
+// ParseJWT checks the token signature against the key named by its kid,
+// that it has not expired and that its issuer and audience are the
+// configured ones
+func (ks *KeySet) ParseJWT(tokenStr string) (*Claims, error) {
+	claims := &Claims{}
 
-	token, err := jwt.ParseWithClaims(tokenStr, claims, func(t *jwt.Token) (jwtKey any, err error) {
-		return jwtKey, err
+	token, err := jwt.ParseWithClaims(tokenStr, claims, func(t *jwt.Token) (any, error) {
+		return ks.verificationKey(t, time.Now())
 	})
 	if err != nil {
-		if errors.Is(err, jwt.ErrSignatureInvalid) {
-			return nil, ErrInvalidJWTSignature
+		var validationErr *jwt.ValidationError
+		if errors.As(err, &validationErr) {
+			switch {
+			case validationErr.Errors&jwt.ValidationErrorSignatureInvalid != 0:
+				return nil, ErrInvalidJWTSignature
+			case validationErr.Errors&jwt.ValidationErrorExpired != 0:
+				return nil, ErrExpiredJWT
+			}
 		}
-		return nil, err
+		return nil, ErrInvalidJWT
 	}
 
 	if !token.Valid {
 		return nil, ErrInvalidJWT
 	}
 
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return nil, ErrExpiredJWT
+	}
+
+	if claims.Issuer != ks.cfg.Server.JWTIssuer || claims.Audience != ks.cfg.Server.JWTAudience {
+		return nil, ErrInvalidJWT
+	}
+
 	return claims, nil
 }
 
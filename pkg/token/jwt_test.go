@@ -0,0 +1,108 @@
+package token_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-api/pkg/config"
+	"go-api/pkg/token"
+)
+
+func TestExtractJWT(t *testing.T) {
+	cfg := newConfig()
+	keys := newKeySet(t, cfg)
+	id := uuid.New().String()
+
+	valid, err := keys.GenerateJWT("fake@mail.com", id, time.Minute)
+	assert.NoError(t, err)
+
+	expired, err := keys.GenerateJWT("fake@mail.com", id, -time.Minute)
+	assert.NoError(t, err)
+
+	otherSecret := newConfig()
+	otherSecret.Server.JWTSecret = "other_secret"
+	forged, err := newKeySet(t, otherSecret).GenerateJWT("fake@mail.com", id, time.Minute)
+	assert.NoError(t, err)
+
+	otherIssuer := newConfig()
+	otherIssuer.Server.JWTIssuer = "other_issuer"
+	wrongIssuer, err := newKeySet(t, otherIssuer).GenerateJWT("fake@mail.com", id, time.Minute)
+	assert.NoError(t, err)
+
+	otherAudience := newConfig()
+	otherAudience.Server.JWTAudience = "other_audience"
+	wrongAudience, err := newKeySet(t, otherAudience).GenerateJWT("fake@mail.com", id, time.Minute)
+	assert.NoError(t, err)
+
+	unsigned, err := jwt.NewWithClaims(jwt.SigningMethodNone, token.Claims{
+		ID: id,
+		StandardClaims: jwt.StandardClaims{
+			Issuer:    cfg.Server.JWTIssuer,
+			Audience:  cfg.Server.JWTAudience,
+			ExpiresAt: time.Now().Add(time.Minute).Unix(),
+		},
+	}).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		header string
+		err    error
+	}{
+		{name: "Success", header: "Bearer " + valid},
+		{name: "Fail without header", header: "", err: token.ErrMissingJWT},
+		{name: "Fail without bearer scheme", header: valid, err: token.ErrMissingJWT},
+		{name: "Fail with expired token", header: "Bearer " + expired, err: token.ErrExpiredJWT},
+		{name: "Fail with other secret", header: "Bearer " + forged, err: token.ErrInvalidJWTSignature},
+		{name: "Fail with other issuer", header: "Bearer " + wrongIssuer, err: token.ErrInvalidJWT},
+		{name: "Fail with other audience", header: "Bearer " + wrongAudience, err: token.ErrInvalidJWT},
+		{name: "Fail with unsigned token", header: "Bearer " + unsigned, err: token.ErrInvalidJWT},
+		{name: "Fail with malformed token", header: "Bearer fake_token", err: token.ErrInvalidJWT},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				r.Header.Set("Authorization", tt.header)
+			}
+
+			claims, err := keys.ExtractJWT(r)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				assert.Nil(t, claims)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, id, claims.ID)
+			assert.Equal(t, "fake@mail.com", claims.Email)
+		})
+	}
+}
+
+func newKeySet(t *testing.T, cfg *config.Config) *token.KeySet {
+	t.Helper()
+
+	keys, err := token.NewKeySet(cfg)
+	require.NoError(t, err)
+
+	return keys
+}
+
+func newConfig() *config.Config {
+	return &config.Config{
+		Server: config.Server{
+			JWTSecret:   "fake_secret",
+			JWTIssuer:   "fake_issuer",
+			JWTAudience: "fake_audience",
+		},
+	}
+}
@@ -0,0 +1,220 @@
+package token
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+
+	"go-api/pkg/config"
+)
+
+const minRSABits = 2048
+
+// Key is a signing key identified by its kid. Keys loaded from a public key
+// only verify.
+type Key struct {
+	ID        string
+	Method    jwt.SigningMethod
+	Private   crypto.Signer
+	Public    crypto.PublicKey
+	RetiredAt time.Time
+}
+
+// KeySet signs and verifies tokens. With no keys configured it falls back to
+// HS256 with the shared JWTSecret, which is never published.
+type KeySet struct {
+	cfg  *config.Config
+	keys []*Key
+}
+
+// NewKeySet loads the keys of the config from their PEM files. The issuer
+// and the audience are required, as tokens are only accepted when they match.
+func NewKeySet(cfg *config.Config) (*KeySet, error) {
+	if cfg.Server.JWTIssuer == "" || cfg.Server.JWTAudience == "" {
+		return nil, ErrMissingClaimsConfig
+	}
+
+	ks := &KeySet{cfg: cfg}
+
+	seen := make(map[string]bool, len(cfg.Server.JWTKeys))
+	for _, kc := range cfg.Server.JWTKeys {
+		if kc.Kid == "" {
+			return nil, fmt.Errorf("jwt key %s has no kid", kc.File)
+		}
+		if seen[kc.Kid] {
+			return nil, fmt.Errorf("duplicate jwt key kid %s", kc.Kid)
+		}
+		seen[kc.Kid] = true
+
+		key, err := loadKey(kc)
+		if err != nil {
+			return nil, fmt.Errorf("jwt key %s: %w", kc.Kid, err)
+		}
+		ks.keys = append(ks.keys, key)
+	}
+
+	if len(ks.keys) > 0 {
+		if _, err := ks.signingKey(time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	return ks, nil
+}
+
+// canSign reports whether the key signs new tokens at the given time
+func (k *Key) canSign(now time.Time) bool {
+	return k.Private != nil && (k.RetiredAt.IsZero() || now.Before(k.RetiredAt))
+}
+
+// canVerify reports whether tokens signed by the key are still accepted. A
+// retired key keeps verifying during the rotation window, so tokens it
+// signed just before retiring stay valid until they expire.
+func (k *Key) canVerify(now time.Time, window time.Duration) bool {
+	return k.RetiredAt.IsZero() || now.Before(k.RetiredAt.Add(window))
+}
+
+func (ks *KeySet) signingKey(now time.Time) (*Key, error) {
+	for _, k := range ks.keys {
+		if k.canSign(now) {
+			return k, nil
+		}
+	}
+
+	return nil, ErrNoSigningKey
+}
+
+func (ks *KeySet) verificationKey(t *jwt.Token, now time.Time) (any, error) {
+	if len(ks.keys) == 0 {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", t.Header["alg"])
+		}
+		return []byte(ks.cfg.Server.JWTSecret), nil
+	}
+
+	kid, _ := t.Header["kid"].(string)
+	for _, k := range ks.keys {
+		if k.ID != kid {
+			continue
+		}
+		if k.Method.Alg() != t.Method.Alg() || !k.canVerify(now, ks.rotationWindow()) {
+			break
+		}
+		return k.Public, nil
+	}
+
+	return nil, ErrUnknownKey
+}
+
+func (ks *KeySet) rotationWindow() time.Duration {
+	if ks.cfg.Server.JWTRotationWindow > 0 {
+		return ks.cfg.Server.JWTRotationWindow
+	}
+
+	return ks.cfg.Auth.AccessTokenDuration
+}
+
+// JWK is the public part of a key as published in the JWKS
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+}
+
+// JWKS is the document served at /.well-known/jwks.json
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS returns the public keys that still verify tokens, so other services
+// can verify them without sharing a secret
+func (ks *KeySet) JWKS() *JWKS {
+	now := time.Now()
+	set := &JWKS{Keys: make([]JWK, 0, len(ks.keys))}
+
+	for _, k := range ks.keys {
+		if !k.canVerify(now, ks.rotationWindow()) {
+			continue
+		}
+
+		jwk := JWK{Kid: k.ID, Use: "sig", Alg: k.Method.Alg()}
+		switch pub := k.Public.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+
+	return set
+}
+
+// loadKey reads an RSA or Ed25519 key, private or public, from a PEM file
+func loadKey(kc config.JWTKey) (*Key, error) {
+	data, err := os.ReadFile(kc.File)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, jwt.ErrKeyMustBePEMEncoded
+	}
+
+	var parsed any
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		parsed, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported pem block %s", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	key := &Key{ID: kc.Kid, RetiredAt: kc.RetiredAt}
+	if signer, ok := parsed.(crypto.Signer); ok {
+		key.Private = signer
+		parsed = signer.Public()
+	}
+	key.Public = parsed
+
+	switch pub := key.Public.(type) {
+	case *rsa.PublicKey:
+		if pub.N.BitLen() < minRSABits {
+			return nil, fmt.Errorf("rsa keys must have at least %d bits", minRSABits)
+		}
+		key.Method = jwt.SigningMethodRS256
+	case ed25519.PublicKey:
+		key.Method = jwt.SigningMethodEdDSA
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", key.Public)
+	}
+
+	return key, nil
+}
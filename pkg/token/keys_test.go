@@ -0,0 +1,254 @@
+package token_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-api/pkg/config"
+	"go-api/pkg/token"
+)
+
+func TestKeySet_GenerateJWT(t *testing.T) {
+	dir := t.TempDir()
+	rsaFile := writeRSAKey(t, dir)
+	edFile, _ := writeEdKey(t, dir)
+
+	tests := []struct {
+		name string
+		keys []config.JWTKey
+		kid  string
+		alg  string
+	}{
+		{
+			name: "Success with rsa key",
+			keys: []config.JWTKey{{Kid: "rsa", File: rsaFile}},
+			kid:  "rsa",
+			alg:  "RS256",
+		},
+		{
+			name: "Success with ed25519 key",
+			keys: []config.JWTKey{{Kid: "ed", File: edFile}},
+			kid:  "ed",
+			alg:  "EdDSA",
+		},
+		{
+			name: "Success with first key able to sign",
+			keys: []config.JWTKey{
+				{Kid: "retired", File: rsaFile, RetiredAt: time.Now().Add(-time.Minute)},
+				{Kid: "ed", File: edFile},
+			},
+			kid: "ed",
+			alg: "EdDSA",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := newConfig()
+			cfg.Server.JWTKeys = tt.keys
+			keys := newKeySet(t, cfg)
+
+			tokenStr, err := keys.GenerateJWT("fake@mail.com", uuid.NewString(), time.Minute)
+			require.NoError(t, err)
+
+			parsed, _, err := new(jwt.Parser).ParseUnverified(tokenStr, &token.Claims{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.kid, parsed.Header["kid"])
+			assert.Equal(t, tt.alg, parsed.Header["alg"])
+
+			_, err = keys.ParseJWT(tokenStr)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestKeySet_ParseJWT(t *testing.T) {
+	dir := t.TempDir()
+	rsaFile := writeRSAKey(t, dir)
+	edFile, edPublicFile := writeEdKey(t, dir)
+	id := uuid.NewString()
+
+	cfg := newConfig()
+	cfg.Server.JWTKeys = []config.JWTKey{{Kid: "old", File: rsaFile}}
+	oldToken, err := newKeySet(t, cfg).GenerateJWT("fake@mail.com", id, time.Hour)
+	require.NoError(t, err)
+
+	hmacToken, err := newKeySet(t, newConfig()).GenerateJWT("fake@mail.com", id, time.Hour)
+	require.NoError(t, err)
+
+	t.Run("Success with retired key in rotation window", func(t *testing.T) {
+		cfg := newConfig()
+		cfg.Server.JWTRotationWindow = time.Hour
+		cfg.Server.JWTKeys = []config.JWTKey{
+			{Kid: "new", File: edFile},
+			{Kid: "old", File: rsaFile, RetiredAt: time.Now().Add(-time.Minute)},
+		}
+
+		claims, err := newKeySet(t, cfg).ParseJWT(oldToken)
+		assert.NoError(t, err)
+		assert.Equal(t, id, claims.ID)
+	})
+
+	t.Run("Success with public key", func(t *testing.T) {
+		cfg := newConfig()
+		cfg.Server.JWTKeys = []config.JWTKey{{Kid: "ed", File: edFile}}
+		tokenStr, err := newKeySet(t, cfg).GenerateJWT("fake@mail.com", id, time.Hour)
+		require.NoError(t, err)
+
+		cfg.Server.JWTKeys = []config.JWTKey{
+			{Kid: "rsa", File: rsaFile},
+			{Kid: "ed", File: edPublicFile},
+		}
+		_, err = newKeySet(t, cfg).ParseJWT(tokenStr)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Fail with retired key after rotation window", func(t *testing.T) {
+		cfg := newConfig()
+		cfg.Server.JWTRotationWindow = time.Hour
+		cfg.Server.JWTKeys = []config.JWTKey{
+			{Kid: "new", File: edFile},
+			{Kid: "old", File: rsaFile, RetiredAt: time.Now().Add(-2 * time.Hour)},
+		}
+
+		_, err := newKeySet(t, cfg).ParseJWT(oldToken)
+		assert.ErrorIs(t, err, token.ErrInvalidJWT)
+	})
+
+	t.Run("Fail with unknown kid", func(t *testing.T) {
+		cfg := newConfig()
+		cfg.Server.JWTKeys = []config.JWTKey{{Kid: "new", File: edFile}}
+
+		_, err := newKeySet(t, cfg).ParseJWT(oldToken)
+		assert.ErrorIs(t, err, token.ErrInvalidJWT)
+	})
+
+	t.Run("Fail with hmac token once keys are configured", func(t *testing.T) {
+		cfg := newConfig()
+		cfg.Server.JWTKeys = []config.JWTKey{{Kid: "old", File: rsaFile}}
+
+		_, err := newKeySet(t, cfg).ParseJWT(hmacToken)
+		assert.ErrorIs(t, err, token.ErrInvalidJWT)
+	})
+}
+
+func TestNewKeySet(t *testing.T) {
+	dir := t.TempDir()
+	rsaFile := writeRSAKey(t, dir)
+	_, edPublicFile := writeEdKey(t, dir)
+
+	t.Run("Fail without issuer or audience", func(t *testing.T) {
+		for _, server := range []config.Server{
+			{JWTSecret: "fake_secret", JWTAudience: "fake_audience"},
+			{JWTSecret: "fake_secret", JWTIssuer: "fake_issuer"},
+		} {
+			keys, err := token.NewKeySet(&config.Config{Server: server})
+			assert.ErrorIs(t, err, token.ErrMissingClaimsConfig)
+			assert.Nil(t, keys)
+		}
+	})
+
+	tests := []struct {
+		name string
+		keys []config.JWTKey
+	}{
+		{name: "Fail without kid", keys: []config.JWTKey{{File: rsaFile}}},
+		{name: "Fail with duplicate kid", keys: []config.JWTKey{{Kid: "a", File: rsaFile}, {Kid: "a", File: rsaFile}}},
+		{name: "Fail with missing file", keys: []config.JWTKey{{Kid: "a", File: filepath.Join(dir, "missing.pem")}}},
+		{name: "Fail without signing key", keys: []config.JWTKey{{Kid: "a", File: edPublicFile}}},
+		{name: "Fail with every key retired", keys: []config.JWTKey{{Kid: "a", File: rsaFile, RetiredAt: time.Now().Add(-time.Minute)}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := newConfig()
+			cfg.Server.JWTKeys = tt.keys
+
+			keys, err := token.NewKeySet(cfg)
+			assert.Error(t, err)
+			assert.Nil(t, keys)
+		})
+	}
+}
+
+func TestKeySet_JWKS(t *testing.T) {
+	dir := t.TempDir()
+	rsaFile := writeRSAKey(t, dir)
+	edFile, _ := writeEdKey(t, dir)
+	expiredFile := writeRSAKey(t, dir)
+
+	cfg := newConfig()
+	cfg.Server.JWTRotationWindow = time.Hour
+	cfg.Server.JWTKeys = []config.JWTKey{
+		{Kid: "ed", File: edFile},
+		{Kid: "rsa", File: rsaFile, RetiredAt: time.Now().Add(-time.Minute)},
+		{Kid: "expired", File: expiredFile, RetiredAt: time.Now().Add(-2 * time.Hour)},
+	}
+
+	jwks := newKeySet(t, cfg).JWKS()
+	require.Len(t, jwks.Keys, 2)
+
+	ed := jwks.Keys[0]
+	assert.Equal(t, "ed", ed.Kid)
+	assert.Equal(t, "OKP", ed.Kty)
+	assert.Equal(t, "Ed25519", ed.Crv)
+	assert.Equal(t, "EdDSA", ed.Alg)
+	x, err := base64.RawURLEncoding.DecodeString(ed.X)
+	assert.NoError(t, err)
+	assert.Len(t, x, ed25519.PublicKeySize)
+
+	rsaKey := jwks.Keys[1]
+	assert.Equal(t, "rsa", rsaKey.Kid)
+	assert.Equal(t, "RSA", rsaKey.Kty)
+	assert.Equal(t, "RS256", rsaKey.Alg)
+	assert.Equal(t, "AQAB", rsaKey.E)
+	assert.NotEmpty(t, rsaKey.N)
+
+	assert.Empty(t, newKeySet(t, newConfig()).JWKS().Keys)
+}
+
+func writeRSAKey(t *testing.T, dir string) string {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	return writePEM(t, dir, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(key))
+}
+
+func writeEdKey(t *testing.T, dir string) (string, string) {
+	t.Helper()
+
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	privateDER, err := x509.MarshalPKCS8PrivateKey(private)
+	require.NoError(t, err)
+	publicDER, err := x509.MarshalPKIXPublicKey(public)
+	require.NoError(t, err)
+
+	return writePEM(t, dir, "PRIVATE KEY", privateDER), writePEM(t, dir, "PUBLIC KEY", publicDER)
+}
+
+func writePEM(t *testing.T, dir, blockType string, der []byte) string {
+	t.Helper()
+
+	file := filepath.Join(dir, uuid.NewString()+".pem")
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	require.NoError(t, os.WriteFile(file, data, 0o600))
+
+	return file
+}
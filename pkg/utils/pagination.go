@@ -1,6 +1,13 @@
 package utils
 
-import "math"
+import (
+	"math"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"go-api/pkg/apierrors"
+)
 
 // PaginationQuery params
 type PaginationQuery struct {
@@ -9,6 +16,25 @@ type PaginationQuery struct {
 	OrderBy string `json:"order_by,omitempty"`
 }
 
+// Get pagination query from the size, page and order_by query params
+func GetPaginationFromCtx(c *gin.Context) (*PaginationQuery, error) {
+	size, err := strconv.Atoi(c.Query("size"))
+	if err != nil || size <= 0 {
+		return nil, apierrors.BadRequest("invalid size")
+	}
+
+	page, err := strconv.Atoi(c.Query("page"))
+	if err != nil || page < 0 {
+		return nil, apierrors.BadRequest("invalid page")
+	}
+
+	return &PaginationQuery{
+		Size:    size,
+		Page:    page,
+		OrderBy: c.Query("order_by"),
+	}, nil
+}
+
 // Get offset
 func (q *PaginationQuery) GetOffset() int {
 	if q.Page == 0 {